@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyverno/kyverno/pkg/engine/variables/regex"
+	"github.com/robfig/cron"
 	"github.com/sigstore/k8s-manifest-sigstore/pkg/k8smanifest"
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -86,6 +87,10 @@ type ContextEntry struct {
 
 	// Variable defines an arbitrary JMESPath context variable that can be defined inline.
 	Variable *Variable `json:"variable,omitempty" yaml:"variable,omitempty"`
+
+	// Timeout is the maximum duration allowed to load the context entry.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 // Variable defines an arbitrary JMESPath context variable that can be defined inline.
@@ -297,9 +302,39 @@ type Mutation struct {
 	// +optional
 	PatchesJSON6902 string `json:"patchesJson6902,omitempty" yaml:"patchesJson6902,omitempty"`
 
+	// PatchJSONMerge is a RFC 7386 JSON Merge Patch used to modify resources.
+	// Unlike strategic merge patches, lists are always replaced.
+	// See https://tools.ietf.org/html/rfc7386.
+	// +optional
+	RawPatchJSONMerge *apiextv1.JSON `json:"patchJsonMerge,omitempty" yaml:"patchJsonMerge,omitempty"`
+
+	// Expressions is a list of expressions computing the values of specific paths in the resource.
+	// +optional
+	Expressions []MutationExpression `json:"expressions,omitempty" yaml:"expressions,omitempty"`
+
 	// ForEach applies mutation rules to a list of sub-elements by creating a context for each entry in the list and looping over it to apply the specified logic.
 	// +optional
 	ForEachMutation []ForEachMutation `json:"foreach,omitempty" yaml:"foreach,omitempty"`
+
+	// Schedule is a cron expression at which the background controller applies a mutateExisting rule
+	// to all the resources it matches, in addition to the admission events of the triggers.
+	// Requires targets.
+	// +optional
+	Schedule string `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+}
+
+// ValidateSchedule checks the schedule is a valid cron expression and is only set on mutateExisting rules
+func (m *Mutation) ValidateSchedule(path *field.Path) (errs field.ErrorList) {
+	if m.Schedule == "" {
+		return nil
+	}
+	if len(m.Targets) == 0 {
+		errs = append(errs, field.Invalid(path, m.Schedule, "schedule is only supported by mutateExisting rules"))
+	}
+	if _, err := cron.ParseStandard(m.Schedule); err != nil {
+		errs = append(errs, field.Invalid(path, m.Schedule, "schedule is not in proper cron format"))
+	}
+	return errs
 }
 
 func (m *Mutation) GetPatchStrategicMerge() apiextensions.JSON {
@@ -310,6 +345,32 @@ func (m *Mutation) SetPatchStrategicMerge(in apiextensions.JSON) {
 	m.RawPatchStrategicMerge = ToJSON(in)
 }
 
+func (m *Mutation) GetPatchJSONMerge() apiextensions.JSON {
+	return FromJSON(m.RawPatchJSONMerge)
+}
+
+func (m *Mutation) SetPatchJSONMerge(in apiextensions.JSON) {
+	m.RawPatchJSONMerge = ToJSON(in)
+}
+
+// MutationExpression computes the value of a path in the resource.
+// Exactly one of JMESPath or CEL must be specified.
+type MutationExpression struct {
+	// Path is a RFC 6901 JSON Pointer to the field to set, for example /metadata/labels/team.
+	// Missing parents are created.
+	Path string `json:"path" yaml:"path"`
+
+	// JMESPath is a JMESPath expression evaluated against the rule context,
+	// the result is used as the value of the field.
+	// +optional
+	JMESPath string `json:"jmesPath,omitempty" yaml:"jmesPath,omitempty"`
+
+	// CEL is a CEL expression, the result is used as the value of the field.
+	// The resource being mutated is available as `object` and the current foreach element (if any) as `element`.
+	// +optional
+	CEL string `json:"cel,omitempty" yaml:"cel,omitempty"`
+}
+
 // ForEachMutation applies mutation rules to a list of sub-elements by creating a context for each entry in the list and looping over it to apply the specified logic.
 type ForEachMutation struct {
 	// List specifies a JMESPath expression that results in one or more elements
@@ -343,6 +404,16 @@ type ForEachMutation struct {
 	// +optional
 	PatchesJSON6902 string `json:"patchesJson6902,omitempty" yaml:"patchesJson6902,omitempty"`
 
+	// PatchJSONMerge is a RFC 7386 JSON Merge Patch used to modify resources.
+	// Unlike strategic merge patches, lists are always replaced.
+	// See https://tools.ietf.org/html/rfc7386.
+	// +optional
+	RawPatchJSONMerge *apiextv1.JSON `json:"patchJsonMerge,omitempty" yaml:"patchJsonMerge,omitempty"`
+
+	// Expressions is a list of expressions computing the values of specific paths in the resource.
+	// +optional
+	Expressions []MutationExpression `json:"expressions,omitempty" yaml:"expressions,omitempty"`
+
 	// Foreach declares a nested foreach iterator
 	// +optional
 	ForEachMutation *apiextv1.JSON `json:"foreach,omitempty" yaml:"foreach,omitempty"`
@@ -356,6 +427,14 @@ func (m *ForEachMutation) SetPatchStrategicMerge(in apiextensions.JSON) {
 	m.RawPatchStrategicMerge = ToJSON(in)
 }
 
+func (m *ForEachMutation) GetPatchJSONMerge() apiextensions.JSON {
+	return FromJSON(m.RawPatchJSONMerge)
+}
+
+func (m *ForEachMutation) SetPatchJSONMerge(in apiextensions.JSON) {
+	m.RawPatchJSONMerge = ToJSON(in)
+}
+
 // Validation defines checks to be performed on matching resources.
 type Validation struct {
 	// Message specifies a custom message to be displayed on failure.
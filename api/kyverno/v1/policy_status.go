@@ -33,6 +33,19 @@ type PolicyStatus struct {
 	// RuleCount describes total number of rules in a policy
 	// +optional
 	RuleCount RuleCountStatus `json:"rulecount" yaml:"rulecount"`
+	// Sweeps contains the status of the scheduled sweeps of the mutateExisting rules
+	// +optional
+	Sweeps []SweepStatus `json:"sweeps,omitempty" yaml:"sweeps,omitempty"`
+}
+
+// SweepStatus contains the status of the last scheduled sweep of a mutateExisting rule
+type SweepStatus struct {
+	// Rule is the name of the mutateExisting rule
+	Rule string `json:"rule" yaml:"rule"`
+	// LastSweepTime is the time at which the last sweep started
+	LastSweepTime metav1.Time `json:"lastSweepTime" yaml:"lastSweepTime"`
+	// Count is the number of update requests created by the last sweep
+	Count int `json:"count" yaml:"count"`
 }
 
 // RuleCountStatus contains four variables which describes counts for
@@ -70,6 +83,30 @@ func (status *PolicyStatus) IsReady() bool {
 	return condition != nil && condition.Status == metav1.ConditionTrue
 }
 
+// GetSweep returns the status of the last sweep of the rule, nil if the rule was never swept
+func (status *PolicyStatus) GetSweep(rule string) *SweepStatus {
+	for i := range status.Sweeps {
+		if status.Sweeps[i].Rule == rule {
+			return &status.Sweeps[i]
+		}
+	}
+	return nil
+}
+
+// SetSweep records the status of the last sweep of the rule
+func (status *PolicyStatus) SetSweep(rule string, lastSweepTime metav1.Time, count int) {
+	sweep := SweepStatus{
+		Rule:          rule,
+		LastSweepTime: lastSweepTime,
+		Count:         count,
+	}
+	if existing := status.GetSweep(rule); existing != nil {
+		*existing = sweep
+		return
+	}
+	status.Sweeps = append(status.Sweeps, sweep)
+}
+
 // AutogenStatus contains autogen status information.
 type AutogenStatus struct {
 	// Rules is a list of Rule instances. It contains auto generated rules added for pod controllers
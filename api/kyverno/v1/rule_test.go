@@ -1137,3 +1137,33 @@ func Test_Validate_ClusterPolicy_Generate_Variables(t *testing.T) {
 		assert.Equal(t, len(errs) != 0, testcase.shouldFail, testcase.name)
 	}
 }
+
+func Test_Mutation_ValidateSchedule(t *testing.T) {
+	path := field.NewPath("dummy")
+	targets := []TargetResourceSpec{{ResourceSpec: ResourceSpec{APIVersion: "v1", Kind: "Secret"}}}
+	testcases := []struct {
+		description string
+		mutation    Mutation
+		errors      int
+	}{{
+		description: "no schedule",
+		mutation:    Mutation{},
+	}, {
+		description: "valid schedule",
+		mutation:    Mutation{Targets: targets, Schedule: "0 */6 * * *"},
+	}, {
+		description: "invalid schedule",
+		mutation:    Mutation{Targets: targets, Schedule: "every hour"},
+		errors:      1,
+	}, {
+		description: "schedule without targets",
+		mutation:    Mutation{Schedule: "@hourly"},
+		errors:      1,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			errs := tc.mutation.ValidateSchedule(path)
+			assert.Equal(t, len(errs), tc.errors)
+		})
+	}
+}
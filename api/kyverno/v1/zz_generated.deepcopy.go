@@ -402,6 +402,11 @@ func (in *ContextEntry) DeepCopyInto(out *ContextEntry) {
 		*out = new(Variable)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContextEntry.
@@ -474,6 +479,16 @@ func (in *ForEachMutation) DeepCopyInto(out *ForEachMutation) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RawPatchJSONMerge != nil {
+		in, out := &in.RawPatchJSONMerge, &out.RawPatchJSONMerge
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]MutationExpression, len(*in))
+		copy(*out, *in)
+	}
 	if in.ForEachMutation != nil {
 		in, out := &in.ForEachMutation, &out.ForEachMutation
 		*out = new(apiextensionsv1.JSON)
@@ -797,6 +812,16 @@ func (in *Mutation) DeepCopyInto(out *Mutation) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RawPatchJSONMerge != nil {
+		in, out := &in.RawPatchJSONMerge, &out.RawPatchJSONMerge
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]MutationExpression, len(*in))
+		copy(*out, *in)
+	}
 	if in.ForEachMutation != nil {
 		in, out := &in.ForEachMutation, &out.ForEachMutation
 		*out = make([]ForEachMutation, len(*in))
@@ -816,6 +841,21 @@ func (in *Mutation) DeepCopy() *Mutation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutationExpression) DeepCopyInto(out *MutationExpression) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutationExpression.
+func (in *MutationExpression) DeepCopy() *MutationExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(MutationExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectFieldBinding) DeepCopyInto(out *ObjectFieldBinding) {
 	*out = *in
@@ -954,6 +994,13 @@ func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	}
 	in.Autogen.DeepCopyInto(&out.Autogen)
 	out.RuleCount = in.RuleCount
+	if in.Sweeps != nil {
+		in, out := &in.Sweeps, &out.Sweeps
+		*out = make([]SweepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
@@ -1133,6 +1180,11 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
@@ -1237,6 +1289,11 @@ func (in *Spec) DeepCopyInto(out *Spec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MutationOrder != nil {
+		in, out := &in.MutationOrder, &out.MutationOrder
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Spec.
@@ -1274,6 +1331,22 @@ func (in *StaticKeyAttestor) DeepCopy() *StaticKeyAttestor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SweepStatus) DeepCopyInto(out *SweepStatus) {
+	*out = *in
+	in.LastSweepTime.DeepCopyInto(&out.LastSweepTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SweepStatus.
+func (in *SweepStatus) DeepCopy() *SweepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SweepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetResourceSpec) DeepCopyInto(out *TargetResourceSpec) {
 	*out = *in
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	functionNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+	argumentNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// JMESPathType is the type of a JMESPath function argument or return value
+// +kubebuilder:validation:Enum=any;string;number;array;object;array[string];array[number]
+type JMESPathType string
+
+const (
+	JMESPathAny         JMESPathType = "any"
+	JMESPathString      JMESPathType = "string"
+	JMESPathNumber      JMESPathType = "number"
+	JMESPathArray       JMESPathType = "array"
+	JMESPathObject      JMESPathType = "object"
+	JMESPathArrayString JMESPathType = "array[string]"
+	JMESPathArrayNumber JMESPathType = "array[number]"
+)
+
+var jmespathTypes = sets.New(
+	string(JMESPathAny),
+	string(JMESPathString),
+	string(JMESPathNumber),
+	string(JMESPathArray),
+	string(JMESPathObject),
+	string(JMESPathArrayString),
+	string(JMESPathArrayNumber),
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,shortName=jpfn,categories=kyverno
+// +kubebuilder:printcolumn:name="Expression",type=string,JSONPath=".spec.expression"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// JMESPathFunction declares a user defined JMESPath function.
+// The function is named after the resource, with dashes replaced by underscores.
+type JMESPathFunction struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec declares the function arguments and expression.
+	Spec JMESPathFunctionSpec `json:"spec"`
+}
+
+// FunctionName returns the name used to call the function in JMESPath expressions
+func (f *JMESPathFunction) FunctionName() string {
+	return strings.ReplaceAll(f.Name, "-", "_")
+}
+
+// Validate implements programmatic validation
+func (f *JMESPathFunction) Validate() (errs field.ErrorList) {
+	if !functionNameRegex.MatchString(f.Name) {
+		errs = append(errs, field.Invalid(field.NewPath("metadata").Child("name"), f.Name, "name must start with a lowercase letter and contain only lowercase letters, digits and dashes"))
+	}
+	errs = append(errs, f.Spec.Validate(field.NewPath("spec"))...)
+	return errs
+}
+
+// JMESPathFunctionSpec stores the definition of a user defined JMESPath function.
+type JMESPathFunctionSpec struct {
+	// Arguments declares the function arguments, in order.
+	// Argument values are available by name in the expression.
+	// +optional
+	Arguments []JMESPathFunctionArgument `json:"arguments,omitempty"`
+
+	// Expression is the JMESPath expression evaluated when the function is called.
+	// It can call builtin functions but not other user defined functions.
+	Expression string `json:"expression"`
+
+	// ReturnType is the type returned by the function, it is used for documentation only.
+	// +optional
+	ReturnType JMESPathType `json:"returnType,omitempty"`
+
+	// Description describes what the function does.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// Validate implements programmatic validation
+func (s *JMESPathFunctionSpec) Validate(path *field.Path) (errs field.ErrorList) {
+	names := sets.New[string]()
+	for i, argument := range s.Arguments {
+		argumentPath := path.Child("arguments").Index(i)
+		if !argumentNameRegex.MatchString(argument.Name) {
+			errs = append(errs, field.Invalid(argumentPath.Child("name"), argument.Name, "name must be a valid JMESPath identifier"))
+		} else if names.Has(argument.Name) {
+			errs = append(errs, field.Duplicate(argumentPath.Child("name"), argument.Name))
+		}
+		names.Insert(argument.Name)
+		if argument.Type != "" && !jmespathTypes.Has(string(argument.Type)) {
+			errs = append(errs, field.NotSupported(argumentPath.Child("type"), argument.Type, sets.List(jmespathTypes)))
+		}
+	}
+	if s.Expression == "" {
+		errs = append(errs, field.Required(path.Child("expression"), "an expression is required"))
+	}
+	if s.ReturnType != "" && !jmespathTypes.Has(string(s.ReturnType)) {
+		errs = append(errs, field.NotSupported(path.Child("returnType"), s.ReturnType, sets.List(jmespathTypes)))
+	}
+	return errs
+}
+
+// JMESPathFunctionArgument declares an argument of a user defined JMESPath function.
+type JMESPathFunctionArgument struct {
+	// Name is the argument name, used to reference the argument value in the expression.
+	Name string `json:"name"`
+
+	// Type is the expected type of the argument.
+	// +kubebuilder:default=any
+	// +optional
+	Type JMESPathType `json:"type,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JMESPathFunctionList is a list of JMESPathFunction instances.
+type JMESPathFunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []JMESPathFunction `json:"items"`
+}
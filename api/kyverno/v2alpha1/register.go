@@ -53,6 +53,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CleanupPolicyList{},
 		&ClusterCleanupPolicy{},
 		&ClusterCleanupPolicyList{},
+		&JMESPathFunction{},
+		&JMESPathFunctionList{},
 		&PolicyException{},
 		&PolicyExceptionList{},
 	)
@@ -221,6 +221,99 @@ func (in *Exception) DeepCopy() *Exception {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JMESPathFunction) DeepCopyInto(out *JMESPathFunction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JMESPathFunction.
+func (in *JMESPathFunction) DeepCopy() *JMESPathFunction {
+	if in == nil {
+		return nil
+	}
+	out := new(JMESPathFunction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JMESPathFunction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JMESPathFunctionArgument) DeepCopyInto(out *JMESPathFunctionArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JMESPathFunctionArgument.
+func (in *JMESPathFunctionArgument) DeepCopy() *JMESPathFunctionArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(JMESPathFunctionArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JMESPathFunctionList) DeepCopyInto(out *JMESPathFunctionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JMESPathFunction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JMESPathFunctionList.
+func (in *JMESPathFunctionList) DeepCopy() *JMESPathFunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(JMESPathFunctionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JMESPathFunctionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JMESPathFunctionSpec) DeepCopyInto(out *JMESPathFunctionSpec) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]JMESPathFunctionArgument, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JMESPathFunctionSpec.
+func (in *JMESPathFunctionSpec) DeepCopy() *JMESPathFunctionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JMESPathFunctionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyException) DeepCopyInto(out *PolicyException) {
 	*out = *in
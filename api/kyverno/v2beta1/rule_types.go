@@ -5,6 +5,7 @@ import (
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	datautils "github.com/kyverno/kyverno/pkg/utils/data"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -60,6 +61,11 @@ type Rule struct {
 	// VerifyImages is used to verify image signatures and mutate them to add a digest
 	// +optional
 	VerifyImages []ImageVerification `json:"verifyImages,omitempty" yaml:"verifyImages,omitempty"`
+
+	// Timeout is the maximum duration allowed to process the rule, including loading context entries.
+	// When the timeout is exceeded the rule reports an error.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 // HasMutate checks for mutate rule
@@ -180,6 +186,19 @@ func (r *Rule) ValidateGenerate(path *field.Path, clusterResources sets.Set[stri
 	return r.Generation.Validate(path, clusterResources)
 }
 
+// ValidateTimeouts checks the rule and context entries timeouts are positive durations
+func (r *Rule) ValidateTimeouts(path *field.Path) (errs field.ErrorList) {
+	if r.Timeout != nil && r.Timeout.Duration <= 0 {
+		errs = append(errs, field.Invalid(path.Child("timeout"), r.Timeout.Duration.String(), "timeout must be a positive duration"))
+	}
+	for idx, entry := range r.Context {
+		if entry.Timeout != nil && entry.Timeout.Duration <= 0 {
+			errs = append(errs, field.Invalid(path.Child("context").Index(idx).Child("timeout"), entry.Timeout.Duration.String(), "timeout must be a positive duration"))
+		}
+	}
+	return errs
+}
+
 // Validate implements programmatic validation
 func (r *Rule) Validate(path *field.Path, namespaced bool, clusterResources sets.Set[string]) (errs field.ErrorList) {
 	errs = append(errs, r.ValidateRuleType(path)...)
@@ -187,5 +206,7 @@ func (r *Rule) Validate(path *field.Path, namespaced bool, clusterResources sets
 	errs = append(errs, r.MatchResources.Validate(path.Child("match"), namespaced, clusterResources)...)
 	errs = append(errs, r.ExcludeResources.Validate(path.Child("exclude"), namespaced, clusterResources)...)
 	errs = append(errs, r.ValidateGenerate(path, clusterResources)...)
+	errs = append(errs, r.ValidateTimeouts(path)...)
+	errs = append(errs, r.Mutation.ValidateSchedule(path.Child("mutate").Child("schedule"))...)
 	return errs
 }
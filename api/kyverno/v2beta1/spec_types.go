@@ -72,6 +72,13 @@ type Spec struct {
 	// Defaults to "false" if not specified.
 	// +optional
 	GenerateExisting bool `json:"generateExisting,omitempty" yaml:"generateExisting,omitempty"`
+
+	// MutationOrder defines the order in which policies mutate resources during admission.
+	// Policies with a lower order are applied first, a policy with a higher order takes precedence
+	// when several policies mutate the same field. Policies with the same order are applied in an unspecified order.
+	// Defaults to 0.
+	// +optional
+	MutationOrder *int32 `json:"mutationOrder,omitempty" yaml:"mutationOrder,omitempty"`
 }
 
 func (s *Spec) SetRules(rules []Rule) {
@@ -163,6 +170,14 @@ func (s *Spec) BackgroundProcessingEnabled() bool {
 	return *s.Background
 }
 
+// GetMutationOrder returns the mutation order of the policy, 0 if not set
+func (s *Spec) GetMutationOrder() int32 {
+	if s.MutationOrder == nil {
+		return 0
+	}
+	return *s.MutationOrder
+}
+
 // IsMutateExisting checks if the mutate policy applies to existing resources
 func (s *Spec) IsMutateExisting() bool {
 	for _, rule := range s.Rules {
@@ -11,10 +11,14 @@ import (
 
 	"github.com/kyverno/kyverno/cmd/internal"
 	"github.com/kyverno/kyverno/pkg/background"
+	"github.com/kyverno/kyverno/pkg/background/generate"
 	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
 	kyvernoinformer "github.com/kyverno/kyverno/pkg/client/informers/externalversions"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
+	driftcontroller "github.com/kyverno/kyverno/pkg/controllers/drift"
+	jmespathcontroller "github.com/kyverno/kyverno/pkg/controllers/jmespath"
 	policymetricscontroller "github.com/kyverno/kyverno/pkg/controllers/metrics/policy"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
@@ -25,6 +29,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/policy"
 	"github.com/kyverno/kyverno/pkg/registryclient"
 	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/util/flowcontrol"
 	kyamlopenapi "sigs.k8s.io/kustomize/kyaml/openapi"
 )
 
@@ -43,7 +48,11 @@ func createrLeaderControllers(
 	configuration config.Configuration,
 	metricsConfig metrics.MetricsConfigManager,
 	eventGenerator event.Interface,
+	cloudEventsPublisher cloudevents.Interface,
 	jp jmespath.Interface,
+	driftInterval time.Duration,
+	driftRemediation bool,
+	sweepRateLimiter flowcontrol.RateLimiter,
 ) ([]internal.Controller, error) {
 	policyCtrl, err := policy.NewPolicyController(
 		kyvernoClient,
@@ -59,6 +68,7 @@ func createrLeaderControllers(
 		time.Hour,
 		metricsConfig,
 		jp,
+		sweepRateLimiter,
 	)
 	if err != nil {
 		return nil, err
@@ -72,25 +82,59 @@ func createrLeaderControllers(
 		kyvernoInformer.Kyverno().V1beta1().UpdateRequests(),
 		kubeInformer.Core().V1().Namespaces(),
 		eventGenerator,
+		cloudEventsPublisher,
 		configuration,
 		jp,
 	)
-	return []internal.Controller{
+	controllers := []internal.Controller{
 		internal.NewController("policy-controller", policyCtrl, 2),
 		internal.NewController("background-controller", backgroundController, genWorkers),
-	}, err
+	}
+	if driftInterval > 0 {
+		driftDetector := generate.NewDriftDetector(
+			dynamicClient,
+			eng,
+			kubeInformer.Core().V1().Namespaces().Lister(),
+			configuration,
+			jp,
+			logging.WithName("DriftDetector"),
+		)
+		driftController := driftcontroller.NewController(
+			kyvernoClient,
+			kyvernoInformer.Kyverno().V1().ClusterPolicies(),
+			kyvernoInformer.Kyverno().V1().Policies(),
+			driftDetector,
+			eventGenerator,
+			driftInterval,
+			driftRemediation,
+		)
+		controllers = append(controllers, internal.NewController(driftcontroller.ControllerName, driftController, driftcontroller.Workers))
+	}
+	return controllers, err
 }
 
 func main() {
 	var (
-		genWorkers      int
-		maxQueuedEvents int
-		omitEvents      string
+		genWorkers             int
+		maxQueuedEvents        int
+		maxQueuedCloudEvents   int
+		omitEvents             string
+		eventAggregationWindow time.Duration
+		driftInterval          time.Duration
+		driftRemediation       bool
+		sweepQPS               float64
+		sweepBurst             int
 	)
 	flagset := flag.NewFlagSet("updaterequest-controller", flag.ExitOnError)
 	flagset.IntVar(&genWorkers, "genWorkers", 10, "Workers for the background controller.")
 	flagset.IntVar(&maxQueuedEvents, "maxQueuedEvents", 1000, "Maximum events to be queued.")
-	flagset.StringVar(&omitEvents, "omit-events", "", "Set this flag to a comma sperated list of PolicyViolation, PolicyApplied, PolicyError, PolicySkipped to disable events, e.g. --omit-events=PolicyApplied,PolicyViolation")
+	flagset.IntVar(&maxQueuedCloudEvents, "maxQueuedCloudEvents", cloudevents.DefaultMaxQueuedEvents, "Maximum CloudEvents to be queued before new events are dropped.")
+	flagset.StringVar(&omitEvents, "omit-events", "", "Set this flag to a comma sperated list of PolicyViolation, PolicyApplied, PolicyError, PolicySkipped, ResourceGenerated, ResourceDrifted to disable events, e.g. --omit-events=PolicyApplied,PolicyViolation")
+	flagset.DurationVar(&eventAggregationWindow, "eventAggregationWindow", event.DefaultAggregationWindow, "Minimum duration between two identical events, identical events received within the window are aggregated into a single event, events are not aggregated if zero.")
+	flagset.DurationVar(&driftInterval, "generateDriftInterval", 0, "Interval at which resources generated by synchronized generate rules (data, clone and cloneList) and targets of mutateExisting rules are compared with their expected state, drift detection is disabled if zero.")
+	flagset.BoolVar(&driftRemediation, "generateDriftRemediation", false, "Set this flag to 'true' to re-apply the expected state of generated resources and mutateExisting targets that drifted.")
+	flagset.Float64Var(&sweepQPS, "mutateExistingSweepQPS", 10, "Maximum number of update requests per second created by the scheduled sweeps of mutateExisting rules.")
+	flagset.IntVar(&sweepBurst, "mutateExistingSweepBurst", 20, "Maximum burst of update requests created by the scheduled sweeps of mutateExisting rules.")
 	// config
 	appConfig := internal.NewConfiguration(
 		internal.WithProfiling(),
@@ -126,8 +170,15 @@ func main() {
 		kyvernoInformer.Kyverno().V1().Policies(),
 		maxQueuedEvents,
 		emitEventsValues,
+		eventAggregationWindow,
 		logging.WithName("EventGenerator"),
 	)
+	cloudEventsPublisher := cloudevents.NewPublisher(
+		setup.Configuration,
+		setup.KubeClient.CoreV1().Secrets(config.KyvernoNamespace()),
+		maxQueuedCloudEvents,
+		logging.WithName("CloudEventsPublisher"),
+	)
 	// this controller only subscribe to events, nothing is returned...
 	var wg sync.WaitGroup
 	policymetricscontroller.NewController(
@@ -141,19 +192,33 @@ func main() {
 		setup.Logger,
 		setup.Configuration,
 		setup.MetricsConfiguration,
+		setup.MetricsManager,
 		setup.Jp,
 		setup.KyvernoDynamicClient,
 		setup.RegistryClient,
 		setup.KubeClient,
 		setup.KyvernoClient,
+		1,
+	)
+	jmespathController := jmespathcontroller.NewController(
+		kyvernoInformer.Kyverno().V2alpha1().JMESPathFunctions(),
 	)
 	// start informers and wait for cache sync
 	if !internal.StartInformersAndWaitForCacheSync(signalCtx, setup.Logger, kyvernoInformer) {
 		setup.Logger.Error(errors.New("failed to wait for cache sync"), "failed to wait for cache sync")
 		os.Exit(1)
 	}
+	// register user defined jmespath functions
+	if err := jmespathController.WarmUp(); err != nil {
+		setup.Logger.Error(err, "failed to register jmespath functions")
+		os.Exit(1)
+	}
 	// start event generator
 	go eventGenerator.Run(signalCtx, 3, &wg)
+	// start cloud events publisher
+	cloudEventsPublisher.Run(signalCtx, &wg)
+	// start non leader controllers
+	internal.NewController(jmespathcontroller.ControllerName, jmespathController, jmespathcontroller.Workers).Run(signalCtx, setup.Logger.WithName("controllers"), &wg)
 	// setup leader election
 	le, err := leaderelection.New(
 		setup.Logger.WithName("leader-election"),
@@ -179,7 +244,11 @@ func main() {
 				setup.Configuration,
 				setup.MetricsManager,
 				eventGenerator,
+				cloudEventsPublisher,
 				setup.Jp,
+				driftInterval,
+				driftRemediation,
+				flowcontrol.NewTokenBucketRateLimiter(float32(sweepQPS), sweepBurst),
 			)
 			if err != nil {
 				logger.Error(err, "failed to create leader controllers")
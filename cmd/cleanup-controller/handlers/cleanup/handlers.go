@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	kyvernov1beta1 "github.com/kyverno/kyverno/api/kyverno/v1beta1"
 	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
 	kyvernov2alpha1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v2alpha1"
@@ -108,6 +109,13 @@ func (h *handlers) lookupPolicy(namespace, name string) (kyvernov2alpha1.Cleanup
 	}
 }
 
+// loadAPIData loads an api call context entry, enforcing the entry timeout (if any).
+func (h *handlers) loadAPIData(ctx context.Context, logger logr.Logger, entry kyvernov1.ContextEntry, enginectx enginecontext.Interface) error {
+	return engineapi.LoadWithTimeout(ctx, entry, func(ctx context.Context) error {
+		return engineapi.LoadAPIData(ctx, h.jp, logger, entry, enginectx, h.client)
+	})
+}
+
 func (h *handlers) executePolicy(ctx context.Context, logger logr.Logger, policy kyvernov2alpha1.CleanupPolicyInterface, cfg config.Configuration) error {
 	spec := policy.GetSpec()
 	kinds := sets.New(spec.MatchResources.GetKinds()...)
@@ -118,7 +126,7 @@ func (h *handlers) executePolicy(ctx context.Context, logger logr.Logger, policy
 	if spec.Context != nil {
 		for _, entry := range spec.Context {
 			if entry.APICall != nil {
-				if err := engineapi.LoadAPIData(ctx, h.jp, logger, entry, enginectx, h.client); err != nil {
+				if err := h.loadAPIData(ctx, logger, entry, enginectx); err != nil {
 					return err
 				}
 			} else if entry.Variable != nil {
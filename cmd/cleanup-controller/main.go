@@ -17,11 +17,11 @@ import (
 	"github.com/kyverno/kyverno/pkg/controllers/cleanup"
 	genericloggingcontroller "github.com/kyverno/kyverno/pkg/controllers/generic/logging"
 	genericwebhookcontroller "github.com/kyverno/kyverno/pkg/controllers/generic/webhook"
+	jmespathcontroller "github.com/kyverno/kyverno/pkg/controllers/jmespath"
 	"github.com/kyverno/kyverno/pkg/leaderelection"
 	"github.com/kyverno/kyverno/pkg/tls"
 	"github.com/kyverno/kyverno/pkg/webhooks"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
-	corev1 "k8s.io/api/core/v1"
 	kubeinformers "k8s.io/client-go/informers"
 )
 
@@ -65,6 +65,8 @@ func main() {
 		internal.WithLeaderElection(),
 		internal.WithKyvernoClient(),
 		internal.WithKyvernoDynamicClient(),
+		internal.WithCertificates(),
+		internal.WithWebhookServer(),
 		internal.WithFlagSets(flagset),
 	)
 	// parse flags
@@ -93,8 +95,9 @@ func main() {
 				setup.KubeClient.CoreV1().Secrets(config.KyvernoNamespace()),
 				secretLister,
 				tls.CertRenewalInterval,
-				tls.CAValidityDuration,
-				tls.TLSValidityDuration,
+				internal.CAValidityDuration(),
+				internal.TLSValidityDuration(),
+				internal.CertKeyAlgorithm(),
 				serverIP,
 			)
 			certController := internal.NewController(
@@ -171,7 +174,6 @@ func main() {
 	kubeKyvernoInformer := kubeinformers.NewSharedInformerFactoryWithOptions(setup.KubeClient, resyncPeriod, kubeinformers.WithNamespace(config.KyvernoNamespace()))
 	kyvernoInformer := kyvernoinformer.NewSharedInformerFactory(setup.KyvernoClient, resyncPeriod)
 	// listers
-	secretLister := kubeKyvernoInformer.Core().V1().Secrets().Lister().Secrets(config.KyvernoNamespace())
 	cpolLister := kyvernoInformer.Kyverno().V2alpha1().ClusterCleanupPolicies().Lister()
 	polLister := kyvernoInformer.Kyverno().V2alpha1().CleanupPolicies().Lister()
 	nsLister := kubeInformer.Core().V1().Namespaces().Lister()
@@ -188,22 +190,37 @@ func main() {
 		kyvernoInformer.Kyverno().V2alpha1().ClusterCleanupPolicies(),
 		genericloggingcontroller.CheckGeneration,
 	)
+	// webhook server key pair
+	keyPairCache := tls.NewKeyPairCache(kubeKyvernoInformer.Core().V1().Secrets())
+	jmespathController := jmespathcontroller.NewController(
+		kyvernoInformer.Kyverno().V2alpha1().JMESPathFunctions(),
+	)
 	// start informers and wait for cache sync
 	if !internal.StartInformersAndWaitForCacheSync(ctx, setup.Logger, kubeKyvernoInformer, kubeInformer, kyvernoInformer) {
 		os.Exit(1)
 	}
+	// register user defined jmespath functions
+	if err := jmespathController.WarmUp(); err != nil {
+		setup.Logger.Error(err, "failed to register jmespath functions")
+		os.Exit(1)
+	}
+	// start non leader controllers
+	var wg sync.WaitGroup
+	internal.NewController(jmespathcontroller.ControllerName, jmespathController, jmespathcontroller.Workers).Run(ctx, setup.Logger.WithName("controllers"), &wg)
 	// create handlers
 	admissionHandlers := admissionhandlers.New(setup.KyvernoDynamicClient)
 	cleanupHandlers := cleanuphandlers.New(setup.Logger.WithName("cleanup-handler"), setup.KyvernoDynamicClient, cpolLister, polLister, nsLister, setup.Jp)
 	// create server
+	serverOptions, err := internal.WebhookServerOptions()
+	if err != nil {
+		setup.Logger.Error(err, "failed to configure webhook server")
+		os.Exit(1)
+	}
+	// cleanup jobs call the cleanup service without client certificate
+	serverOptions.ClientCertificateExemptPaths = []string{cleanup.CleanupServicePath}
 	server := NewServer(
-		func() ([]byte, []byte, error) {
-			secret, err := secretLister.Get(tls.GenerateTLSPairSecretName())
-			if err != nil {
-				return nil, nil, err
-			}
-			return secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], nil
-		},
+		keyPairCache.GetCertificate,
+		serverOptions,
 		admissionHandlers.Validate,
 		cleanupHandlers.Cleanup,
 		setup.MetricsManager,
@@ -217,4 +234,5 @@ func main() {
 	server.Run(ctx.Done())
 	// start leader election
 	le.Run(ctx)
+	wg.Wait()
 }
@@ -29,7 +29,7 @@ type server struct {
 }
 
 type (
-	TlsProvider       = func() ([]byte, []byte, error)
+	TlsProvider       = func() (*tls.Certificate, error)
 	ValidationHandler = func(context.Context, logr.Logger, handlers.AdmissionRequest, time.Time) handlers.AdmissionResponse
 	CleanupHandler    = func(context.Context, logr.Logger, string, time.Time, config.Configuration) error
 )
@@ -42,6 +42,7 @@ type Probes interface {
 // NewServer creates new instance of server accordingly to given configuration
 func NewServer(
 	tlsProvider TlsProvider,
+	serverOptions webhooks.ServerOptions,
 	validationHandler ValidationHandler,
 	cleanupHandler CleanupHandler,
 	metricsConfig metrics.MetricsConfigManager,
@@ -87,38 +88,7 @@ func NewServer(
 	mux.HandlerFunc("GET", config.LivenessServicePath, handlers.Probe(probes.IsLive))
 	mux.HandlerFunc("GET", config.ReadinessServicePath, handlers.Probe(probes.IsReady))
 	return &server{
-		server: &http.Server{
-			Addr: ":9443",
-			TLSConfig: &tls.Config{
-				GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
-					certPem, keyPem, err := tlsProvider()
-					if err != nil {
-						return nil, err
-					}
-					pair, err := tls.X509KeyPair(certPem, keyPem)
-					if err != nil {
-						return nil, err
-					}
-					return &pair, nil
-				},
-				MinVersion: tls.VersionTLS12,
-				CipherSuites: []uint16{
-					// AEADs w/ ECDHE
-					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-					tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-				},
-			},
-			Handler:           mux,
-			ReadTimeout:       30 * time.Second,
-			WriteTimeout:      30 * time.Second,
-			ReadHeaderTimeout: 30 * time.Second,
-			IdleTimeout:       5 * time.Minute,
-			ErrorLog:          logging.StdLogger(logging.WithName("server"), ""),
-		},
+		server: webhooks.NewHttpServer(serverOptions, mux, tlsProvider, logging.StdLogger(logging.WithName("server"), "")),
 	}
 }
 
@@ -10,11 +10,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/memfs"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"github.com/kyverno/kyverno/api/kyverno/v1beta1"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
 	sanitizederror "github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/sanitizedError"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/snapshot"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/store"
 	"github.com/kyverno/kyverno/pkg/autogen"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
@@ -67,8 +69,14 @@ type ApplyCommandConfig struct {
 	ResourcePaths   []string
 	PolicyPaths     []string
 	GitBranch       string
+	Snapshot        string
+	Diff            bool
+	Explain         bool
+	FunctionFiles   []string
 	warnExitCode    int
 	warnNoPassed    bool
+	// diffOldPolicies holds the policies loaded from the old path in diff mode
+	diffOldPolicies map[kyvernov1.PolicyInterface]struct{}
 }
 
 var (
@@ -83,10 +91,20 @@ To apply on a folder of resources:
 To apply on a cluster:
         kyverno apply /path/to/policy.yaml /path/to/folderOfPolicies --cluster
 
+To apply on a cluster snapshot exported with "kyverno snapshot":
+        kyverno apply /path/to/policy.yaml /path/to/folderOfPolicies --snapshot /path/to/snapshot.yaml
+
+To compare the impact of a policy change on resources:
+        kyverno apply --diff /path/to/old/policy.yaml /path/to/new/policy.yaml --resource=/path/to/resources/
+        kyverno apply --diff /path/to/old/policy.yaml /path/to/new/policy.yaml --cluster
+
 To apply policies from a gitSourceURL on a cluster:
 	Example: Taking github.com as a gitSourceURL here. Some other standards  gitSourceURL are: gitlab.com , bitbucket.org , etc.
 		kyverno apply https://github.com/kyverno/policies/openshift/ --git-branch main --cluster
 
+To explain how rules were evaluated:
+        kyverno apply /path/to/policy.yaml --resource /path/to/resource.yaml --explain
+
 To apply policy with variables:
 
 	1. To apply single policy with variable on single resource use flag "set".
@@ -172,11 +190,24 @@ func Command() *cobra.Command {
 					}
 				}
 			}()
+			if applyCommandConfig.Diff {
+				hasPassToFail, err := applyCommandConfig.diffCommandHelper(policyPaths)
+				if err != nil {
+					return err
+				}
+				if hasPassToFail {
+					osExit(1)
+				}
+				return nil
+			}
 			applyCommandConfig.PolicyPaths = policyPaths
 			rc, resources, skipInvalidPolicies, pvInfos, err := applyCommandConfig.applyCommandHelper()
 			if err != nil {
 				return err
 			}
+			if applyCommandConfig.Explain {
+				printExplanations(pvInfos)
+			}
 
 			PrintReportOrViolation(applyCommandConfig.PolicyReport, rc, applyCommandConfig.ResourcePaths, len(resources), skipInvalidPolicies, applyCommandConfig.Stdin, pvInfos, applyCommandConfig.warnExitCode, applyCommandConfig.warnNoPassed, applyCommandConfig.AuditWarn)
 			return nil
@@ -196,7 +227,11 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVarP(&applyCommandConfig.KubeConfig, "kubeconfig", "", "", "path to kubeconfig file with authorization and master location information")
 	cmd.Flags().StringVarP(&applyCommandConfig.Context, "context", "", "", "The name of the kubeconfig context to use")
 	cmd.Flags().StringVarP(&applyCommandConfig.GitBranch, "git-branch", "b", "", "test git repository branch")
+	cmd.Flags().StringVarP(&applyCommandConfig.Snapshot, "snapshot", "", "", "Path to a cluster snapshot to apply policies on, instead of a live cluster")
+	cmd.Flags().BoolVarP(&applyCommandConfig.Diff, "diff", "", false, "Compares the results of two versions of policies (old and new paths) applied on the same resources")
 	cmd.Flags().BoolVarP(&applyCommandConfig.AuditWarn, "audit-warn", "", false, "If set to true, will flag audit policies as warnings instead of failures")
+	cmd.Flags().BoolVarP(&applyCommandConfig.Explain, "explain", "", false, "Prints how each rule was evaluated (context entries, preconditions, variables and pattern mismatches)")
+	cmd.Flags().StringSliceVarP(&applyCommandConfig.FunctionFiles, "functions", "", nil, "Load user defined functions from JMESPathFunction manifests")
 	cmd.Flags().IntVar(&applyCommandConfig.warnExitCode, "warn-exit-code", 0, "Set the exit code for warnings; if failures or errors are found, will exit 1")
 	cmd.Flags().BoolVarP(&applyCommandConfig.warnNoPassed, "warn-no-pass", "", false, "Specify if warning exit code should be raised if no objects satisfied a policy; can be used together with --warn-exit-code flag")
 	return cmd
@@ -205,7 +240,9 @@ func Command() *cobra.Command {
 func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, resources []*unstructured.Unstructured, skipInvalidPolicies SkippedInvalidPolicies, responses []engineapi.EngineResponse, err error) {
 	store.SetMock(true)
 	store.SetRegistryAccess(c.RegistryAccess)
-	if c.Cluster {
+	// a snapshot replaces the cluster
+	cluster := c.Cluster || c.Snapshot != ""
+	if cluster {
 		store.AllowApiCall(true)
 	}
 	fs := memfs.New()
@@ -214,6 +251,10 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 		return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("pass the values either using set flag or values_file flag", err)
 	}
 
+	if err := common.LoadJMESPathFunctions(c.FunctionFiles); err != nil {
+		return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("failed to load JMESPath functions", err)
+	}
+
 	variables, globalValMap, valuesMap, namespaceSelectorMap, subresources, err := common.GetVariable(c.VariablesString, c.ValuesFile, fs, false, "")
 	if err != nil {
 		if !sanitizederror.IsErrorSanitized(err) {
@@ -228,7 +269,16 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 	}
 
 	var dClient dclient.Interface
-	if c.Cluster {
+	if c.Snapshot != "" {
+		s, err := snapshot.Load(c.Snapshot)
+		if err != nil {
+			return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("failed to load snapshot", err)
+		}
+		dClient, err = s.Client()
+		if err != nil {
+			return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("failed to create client from snapshot", err)
+		}
+	} else if c.Cluster {
 		restConfig, err := config.CreateClientConfigWithContext(c.KubeConfig, c.Context)
 		if err != nil {
 			return rc, resources, skipInvalidPolicies, responses, err
@@ -258,46 +308,48 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 	var policies []kyvernov1.PolicyInterface
 	var validatingAdmissionPolicies []v1alpha1.ValidatingAdmissionPolicy
 
-	isGit := common.IsGitSourcePath(c.PolicyPaths)
-
-	if isGit {
-		gitSourceURL, err := url.Parse(c.PolicyPaths[0])
-		if err != nil {
-			fmt.Printf("Error: failed to load policies\nCause: %s\n", err)
-			osExit(1)
-		}
-
-		pathElems := strings.Split(gitSourceURL.Path[1:], "/")
-		if len(pathElems) <= 1 {
-			err := fmt.Errorf("invalid URL path %s - expected https://<any_git_source_domain>/:owner/:repository/:branch (without --git-branch flag) OR https://<any_git_source_domain>/:owner/:repository/:directory (with --git-branch flag)", gitSourceURL.Path)
-			fmt.Printf("Error: failed to parse URL \nCause: %s\n", err)
-			osExit(1)
+	if c.Diff {
+		// old and new versions are loaded separately, they usually share the same names
+		c.diffOldPolicies = map[kyvernov1.PolicyInterface]struct{}{}
+		for i, policyPath := range c.PolicyPaths {
+			// each version can come from a different git source
+			policyPaths := []string{policyPath}
+			isGit := common.IsGitSourcePath(policyPaths)
+			policyFs := fs
+			if isGit {
+				policyFs = memfs.New()
+				if policyPaths, err = c.cloneGitPolicies(policyFs, policyPath); err != nil {
+					return rc, resources, skipInvalidPolicies, responses, err
+				}
+			}
+			loaded, loadedValidatingAdmissionPolicies, err := common.GetPoliciesFromPaths(policyFs, policyPaths, isGit, "")
+			if err != nil {
+				fmt.Printf("Error: failed to load policies\nCause: %s\n", err)
+				osExit(1)
+			}
+			if i == 0 {
+				for _, policy := range loaded {
+					c.diffOldPolicies[policy] = struct{}{}
+				}
+			}
+			policies = append(policies, loaded...)
+			validatingAdmissionPolicies = append(validatingAdmissionPolicies, loadedValidatingAdmissionPolicies...)
 		}
-
-		gitSourceURL.Path = strings.Join([]string{pathElems[0], pathElems[1]}, "/")
-		repoURL := gitSourceURL.String()
-		var gitPathToYamls string
-		c.GitBranch, gitPathToYamls = common.GetGitBranchOrPolicyPaths(c.GitBranch, repoURL, c.PolicyPaths)
-		_, cloneErr := gitutils.Clone(repoURL, fs, c.GitBranch)
-		if cloneErr != nil {
-			fmt.Printf("Error: failed to clone repository \nCause: %s\n", cloneErr)
-			log.Log.V(3).Info(fmt.Sprintf("failed to clone repository  %v as it is not valid", repoURL), "error", cloneErr)
-			osExit(1)
+	} else {
+		isGit := common.IsGitSourcePath(c.PolicyPaths)
+		if isGit {
+			if c.PolicyPaths, err = c.cloneGitPolicies(fs, c.PolicyPaths[0]); err != nil {
+				return rc, resources, skipInvalidPolicies, responses, err
+			}
 		}
-		policyYamls, err := gitutils.ListYamls(fs, gitPathToYamls)
+		policies, validatingAdmissionPolicies, err = common.GetPoliciesFromPaths(fs, c.PolicyPaths, isGit, "")
 		if err != nil {
-			return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("failed to list YAMLs in repository", err)
+			fmt.Printf("Error: failed to load policies\nCause: %s\n", err)
+			osExit(1)
 		}
-		sort.Strings(policyYamls)
-		c.PolicyPaths = policyYamls
-	}
-	policies, validatingAdmissionPolicies, err = common.GetPoliciesFromPaths(fs, c.PolicyPaths, isGit, "")
-	if err != nil {
-		fmt.Printf("Error: failed to load policies\nCause: %s\n", err)
-		osExit(1)
 	}
 
-	if len(c.ResourcePaths) == 0 && !c.Cluster {
+	if len(c.ResourcePaths) == 0 && !cluster {
 		return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("resource file(s) or cluster required", err)
 	}
 
@@ -328,13 +380,15 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 		return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("failed to marshal mutated policy", err)
 	}
 
-	resources, err = common.GetResourceAccordingToResourcePath(fs, c.ResourcePaths, c.Cluster, policies, validatingAdmissionPolicies, dClient, c.Namespace, c.PolicyReport, false, "")
+	resources, err = common.GetResourceAccordingToResourcePath(fs, c.ResourcePaths, cluster, policies, validatingAdmissionPolicies, dClient, c.Namespace, c.PolicyReport, false, "")
 	if err != nil {
 		fmt.Printf("Error: failed to load resources\nCause: %s\n", err)
 		osExit(1)
 	}
 
-	if (len(resources) > 1 || len(policies) > 1) && c.VariablesString != "" {
+	// in diff mode every policy is loaded twice (old and new versions)
+	policiesCount := len(policies) - len(c.diffOldPolicies)
+	if (len(resources) > 1 || policiesCount > 1) && c.VariablesString != "" {
 		return rc, resources, skipInvalidPolicies, responses, sanitizederror.NewWithError("currently `set` flag supports variable for single policy applied on single resource ", nil)
 	}
 
@@ -376,7 +430,7 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 	}
 
 	if len(policies) > 0 && len(resources) > 0 {
-		if !c.Stdin {
+		if !c.Stdin && !c.Diff {
 			if mutatedPolicyRulesCount > policyRulesCount {
 				fmt.Printf("\nauto-generated pod policies\nApplying %s to %s...\n", msgPolicyRules, msgResources)
 			} else {
@@ -432,10 +486,11 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 				NamespaceSelectorMap: namespaceSelectorMap,
 				Stdin:                c.Stdin,
 				Rc:                   rc,
-				PrintPatchResource:   true,
+				PrintPatchResource:   !c.Diff,
 				Client:               dClient,
 				AuditWarn:            c.AuditWarn,
 				Subresources:         subresources,
+				Explain:              c.Explain,
 			}
 			ers, err := common.ApplyPolicyOnResource(applyPolicyConfig)
 			if err != nil {
@@ -513,6 +568,63 @@ func (c *ApplyCommandConfig) applyCommandHelper() (rc *common.ResultCounts, reso
 	return rc, resources, skipInvalidPolicies, responses, nil
 }
 
+// cloneGitPolicies clones the git source of the policy path in fs and returns the sorted paths of the policy files
+func (c *ApplyCommandConfig) cloneGitPolicies(fs billy.Filesystem, policyPath string) ([]string, error) {
+	gitSourceURL, err := url.Parse(policyPath)
+	if err != nil {
+		fmt.Printf("Error: failed to load policies\nCause: %s\n", err)
+		osExit(1)
+	}
+
+	pathElems := strings.Split(gitSourceURL.Path[1:], "/")
+	if len(pathElems) <= 1 {
+		err := fmt.Errorf("invalid URL path %s - expected https://<any_git_source_domain>/:owner/:repository/:branch (without --git-branch flag) OR https://<any_git_source_domain>/:owner/:repository/:directory (with --git-branch flag)", gitSourceURL.Path)
+		fmt.Printf("Error: failed to parse URL \nCause: %s\n", err)
+		osExit(1)
+	}
+
+	gitSourceURL.Path = strings.Join([]string{pathElems[0], pathElems[1]}, "/")
+	repoURL := gitSourceURL.String()
+	gitBranch, gitPathToYamls := common.GetGitBranchOrPolicyPaths(c.GitBranch, repoURL, []string{policyPath})
+	_, cloneErr := gitutils.Clone(repoURL, fs, gitBranch)
+	if cloneErr != nil {
+		fmt.Printf("Error: failed to clone repository \nCause: %s\n", cloneErr)
+		log.Log.V(3).Info(fmt.Sprintf("failed to clone repository  %v as it is not valid", repoURL), "error", cloneErr)
+		osExit(1)
+	}
+	policyYamls, err := gitutils.ListYamls(fs, gitPathToYamls)
+	if err != nil {
+		return nil, sanitizederror.NewWithError("failed to list YAMLs in repository", err)
+	}
+	sort.Strings(policyYamls)
+	return policyYamls, nil
+}
+
+// diffCommandHelper applies the old and new versions of policies on the same resources and prints the differences,
+// it returns true if some validation results fail with the new version but did not fail with the old one
+func (c *ApplyCommandConfig) diffCommandHelper(policyPaths []string) (bool, error) {
+	if len(policyPaths) != 2 {
+		return false, sanitizederror.NewWithError("diff requires exactly two policy paths (old and new)", nil)
+	}
+	// results are compared, reports are not printed
+	c.PolicyReport = true
+	c.PolicyPaths = policyPaths
+	_, _, _, responses, err := c.applyCommandHelper()
+	if err != nil {
+		return false, err
+	}
+	var oldResponses, newResponses []engineapi.EngineResponse
+	for _, response := range responses {
+		if _, ok := c.diffOldPolicies[response.Policy()]; ok {
+			oldResponses = append(oldResponses, response)
+		} else {
+			newResponses = append(newResponses, response)
+		}
+	}
+	rows, summary := diffResponses(oldResponses, newResponses)
+	return PrintDiff(rows, summary), nil
+}
+
 // checkMutateLogPath - checking path for printing mutated resource (-o flag)
 func checkMutateLogPath(mutateLogPath string) (mutateLogPathIsDir bool, err error) {
 	if mutateLogPath != "" {
@@ -584,6 +696,28 @@ func PrintReportOrViolation(policyReport bool, rc *common.ResultCounts, resource
 	}
 }
 
+// printExplanations prints the steps recorded by the engine for every rule
+func printExplanations(engineResponses []engineapi.EngineResponse) {
+	divider := "----------------------------------------------------------------------"
+	fmt.Println(divider)
+	fmt.Println("EXPLANATION:")
+	for _, response := range engineResponses {
+		resource := response.Resource
+		for _, rule := range response.PolicyResponse.Rules {
+			steps := rule.Explanation().Steps()
+			if len(steps) == 0 {
+				continue
+			}
+			fmt.Println(divider)
+			fmt.Printf("policy %s -> resource %s/%s/%s, rule %s: %s\n", response.Policy().GetName(), resource.GetKind(), resource.GetNamespace(), resource.GetName(), rule.Name(), rule.Status())
+			for i, step := range steps {
+				fmt.Printf("%d. %s\n", i+1, step)
+			}
+		}
+	}
+	fmt.Println(divider)
+}
+
 // createFileOrFolder - creating file or folder according to path provided
 func createFileOrFolder(mutateLogPath string, mutateLogPathIsDir bool) error {
 	mutateLogPath = filepath.Clean(mutateLogPath)
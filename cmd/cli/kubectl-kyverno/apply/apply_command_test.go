@@ -210,6 +210,25 @@ func Test_Apply(t *testing.T) {
 				},
 			},
 		},
+		{
+			config: ApplyCommandConfig{
+				PolicyPaths:   []string{"../../../../test/cli/apply/jmespath-functions/policy.yaml"},
+				ResourcePaths: []string{"../../../../test/cli/apply/jmespath-functions/resources.yaml"},
+				FunctionFiles: []string{"../../../../test/cli/apply/jmespath-functions/functions.yaml"},
+				PolicyReport:  true,
+			},
+			expectedPolicyReports: []preport.PolicyReport{
+				{
+					Summary: preport.PolicyReportSummary{
+						Pass:  1,
+						Fail:  1,
+						Skip:  4,
+						Error: 0,
+						Warn:  0,
+					},
+				},
+			},
+		},
 	}
 
 	compareSummary := func(expected preport.PolicyReportSummary, actual map[string]interface{}, desc string) {
@@ -0,0 +1,180 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/lensesio/tableprinter"
+)
+
+// DiffRow is a rule result that changed between two versions of a policy
+type DiffRow struct {
+	Type     engineapi.RuleType `header:"type"`
+	Policy   string             `header:"policy"`
+	Rule     string             `header:"rule"`
+	Resource string             `header:"resource"`
+	Old      string             `header:"old"`
+	New      string             `header:"new"`
+}
+
+// DiffSummary counts the changes between two versions of a policy
+type DiffSummary struct {
+	// PassToFail counts validation results that fail with the new version but did not fail with the old one
+	PassToFail int
+	// FailToPass counts validation results that failed with the old version but do not fail with the new one
+	FailToPass int
+	// Mutations counts mutation results that changed
+	Mutations int
+	// Generations counts generate results that changed
+	Generations int
+}
+
+type ruleOutcome struct {
+	status engineapi.RuleStatus
+	// summary is the human readable outcome
+	summary string
+	// detail is used to compare outcomes with the same status
+	detail string
+}
+
+type ruleKey struct {
+	ruleType engineapi.RuleType
+	policy   string
+	rule     string
+	resource string
+}
+
+func outcomesFromResponses(responses ...engineapi.EngineResponse) map[ruleKey]ruleOutcome {
+	outcomes := map[ruleKey]ruleOutcome{}
+	for _, response := range responses {
+		if response.IsValidatingAdmissionPolicy() {
+			continue
+		}
+		policy := response.Policy()
+		policyName := policy.GetName()
+		if policy.GetNamespace() != "" {
+			policyName = policy.GetNamespace() + "/" + policyName
+		}
+		resource := fmt.Sprintf("%s/%s/%s", response.Resource.GetNamespace(), response.Resource.GetKind(), response.Resource.GetName())
+		for i := range response.PolicyResponse.Rules {
+			rule := &response.PolicyResponse.Rules[i]
+			key := ruleKey{
+				ruleType: rule.RuleType(),
+				policy:   policyName,
+				rule:     rule.Name(),
+				resource: resource,
+			}
+			outcome := ruleOutcome{
+				status:  rule.Status(),
+				summary: string(rule.Status()),
+			}
+			if rule.Status() == engineapi.RuleStatusPass {
+				switch rule.RuleType() {
+				case engineapi.Mutation:
+					if patches := rule.Patches(); len(patches) > 0 {
+						detail, _ := json.Marshal(patches)
+						outcome.detail = string(detail)
+						outcome.summary = fmt.Sprintf("pass (%d patches)", len(patches))
+					}
+				case engineapi.Generation:
+					generated := rule.GeneratedResource()
+					if generated.Object != nil {
+						detail, _ := generated.MarshalJSON()
+						outcome.detail = string(detail)
+						outcome.summary = fmt.Sprintf("pass (%s/%s/%s)", generated.GetNamespace(), generated.GetKind(), generated.GetName())
+					}
+				}
+			}
+			outcomes[key] = outcome
+		}
+	}
+	return outcomes
+}
+
+// diffResponses compares the engine responses produced by two versions of the same policies
+func diffResponses(oldResponses, newResponses []engineapi.EngineResponse) ([]DiffRow, DiffSummary) {
+	var rows []DiffRow
+	var summary DiffSummary
+	oldOutcomes := outcomesFromResponses(oldResponses...)
+	newOutcomes := outcomesFromResponses(newResponses...)
+	keys := map[ruleKey]struct{}{}
+	for key := range oldOutcomes {
+		keys[key] = struct{}{}
+	}
+	for key := range newOutcomes {
+		keys[key] = struct{}{}
+	}
+	for key := range keys {
+		oldOutcome, oldFound := oldOutcomes[key]
+		newOutcome, newFound := newOutcomes[key]
+		if oldFound && newFound && oldOutcome == newOutcome {
+			continue
+		}
+		if !oldFound {
+			oldOutcome.summary = "none"
+		}
+		if !newFound {
+			newOutcome.summary = "none"
+		}
+		switch key.ruleType {
+		case engineapi.Validation, engineapi.ImageVerify:
+			if oldOutcome.status == newOutcome.status {
+				continue
+			}
+			if newOutcome.status == engineapi.RuleStatusFail && oldOutcome.status != engineapi.RuleStatusFail {
+				summary.PassToFail++
+			} else if oldOutcome.status == engineapi.RuleStatusFail && newOutcome.status != engineapi.RuleStatusFail {
+				summary.FailToPass++
+			}
+		case engineapi.Mutation:
+			summary.Mutations++
+		case engineapi.Generation:
+			summary.Generations++
+		}
+		rows = append(rows, DiffRow{
+			Type:     key.ruleType,
+			Policy:   key.policy,
+			Rule:     key.rule,
+			Resource: key.resource,
+			Old:      oldOutcome.summary,
+			New:      newOutcome.summary,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Type != rows[j].Type {
+			return rows[i].Type < rows[j].Type
+		}
+		if rows[i].Policy != rows[j].Policy {
+			return rows[i].Policy < rows[j].Policy
+		}
+		if rows[i].Rule != rows[j].Rule {
+			return rows[i].Rule < rows[j].Rule
+		}
+		return rows[i].Resource < rows[j].Resource
+	})
+	return rows, summary
+}
+
+// PrintDiff prints the changes between two versions of a policy side by side,
+// it returns true if some validation results fail with the new version but did not fail with the old one
+func PrintDiff(rows []DiffRow, summary DiffSummary) bool {
+	divider := "----------------------------------------------------------------------"
+	fmt.Println(divider)
+	fmt.Println("POLICY DIFF:")
+	fmt.Println(divider)
+	if len(rows) == 0 {
+		fmt.Println("no changes")
+	} else {
+		printer := tableprinter.New(os.Stdout)
+		printer.BorderTop, printer.BorderBottom, printer.BorderLeft, printer.BorderRight = true, true, true, true
+		printer.CenterSeparator = "│"
+		printer.ColumnSeparator = "│"
+		printer.RowSeparator = "─"
+		printer.Print(rows)
+	}
+	fmt.Printf("\npass to fail: %d, fail to pass: %d, mutations changed: %d, generations changed: %d \n", summary.PassToFail, summary.FailToPass, summary.Mutations, summary.Generations)
+	return summary.PassToFail > 0
+}
@@ -0,0 +1,113 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/mattbaird/jsonpatch"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_diffResponses(t *testing.T) {
+	policy := &kyvernov1.ClusterPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+	}
+	resource := unstructured.Unstructured{}
+	resource.SetKind("Pod")
+	resource.SetNamespace("default")
+	resource.SetName("pod")
+	response := func(rules ...engineapi.RuleResponse) engineapi.EngineResponse {
+		return engineapi.NewEngineResponse(resource, policy, nil).WithPolicyResponse(engineapi.PolicyResponse{Rules: rules})
+	}
+	patch := jsonpatch.NewPatch("add", "/metadata/labels/foo", "bar")
+	oldResponses := []engineapi.EngineResponse{
+		response(
+			*engineapi.RulePass("validate-a", engineapi.Validation, ""),
+			*engineapi.RuleFail("validate-b", engineapi.Validation, ""),
+			*engineapi.RulePass("validate-c", engineapi.Validation, ""),
+		),
+	}
+	newResponses := []engineapi.EngineResponse{
+		response(
+			*engineapi.RuleFail("validate-a", engineapi.Validation, ""),
+			*engineapi.RulePass("validate-b", engineapi.Validation, ""),
+			*engineapi.RulePass("validate-c", engineapi.Validation, ""),
+			*engineapi.RulePass("mutate", engineapi.Mutation, "").WithPatches(patch),
+		),
+	}
+	rows, summary := diffResponses(oldResponses, newResponses)
+	assert.Equal(t, len(rows), 3)
+	assert.Equal(t, summary.PassToFail, 1)
+	assert.Equal(t, summary.FailToPass, 1)
+	assert.Equal(t, summary.Mutations, 1)
+	assert.Equal(t, summary.Generations, 0)
+	assert.Equal(t, rows[0].Rule, "mutate")
+	assert.Equal(t, rows[0].Old, "none")
+	assert.Equal(t, rows[0].New, "pass (1 patches)")
+	assert.Equal(t, rows[1].Rule, "validate-a")
+	assert.Equal(t, rows[1].Old, "pass")
+	assert.Equal(t, rows[1].New, "fail")
+}
+
+func Test_diffCommandHelper(t *testing.T) {
+	policy := `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: require-labels
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: check-labels
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    validate:
+      message: label required
+      pattern:
+        metadata:
+          labels:
+            LABEL: "?*"
+`
+	resource := `apiVersion: v1
+kind: Pod
+metadata:
+  name: pod
+  labels:
+    app: nginx
+spec:
+  containers:
+  - name: nginx
+    image: nginx
+`
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NilError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	oldPolicy := write("old.yaml", strings.ReplaceAll(policy, "LABEL", "app"))
+	newPolicy := write("new.yaml", strings.ReplaceAll(policy, "LABEL", "team"))
+	pod := write("pod.yaml", resource)
+
+	config := ApplyCommandConfig{ResourcePaths: []string{pod}, Diff: true}
+	hasPassToFail, err := config.diffCommandHelper([]string{oldPolicy, newPolicy})
+	assert.NilError(t, err)
+	assert.Assert(t, hasPassToFail)
+
+	config = ApplyCommandConfig{ResourcePaths: []string{pod}, Diff: true}
+	hasPassToFail, err = config.diffCommandHelper([]string{newPolicy, oldPolicy})
+	assert.NilError(t, err)
+	assert.Assert(t, !hasPassToFail)
+
+	config = ApplyCommandConfig{ResourcePaths: []string{pod}, Diff: true}
+	_, err = config.diffCommandHelper([]string{oldPolicy})
+	assert.ErrorContains(t, err, "exactly two policy paths")
+}
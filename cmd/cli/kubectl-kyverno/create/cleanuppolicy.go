@@ -0,0 +1,86 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	kyvernov2beta1 "github.com/kyverno/kyverno/api/kyverno/v2beta1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var cleanupPolicyExample = `
+# Create a ClusterCleanupPolicy deleting pods every hour
+kyverno create cleanup-policy --name cleanup-pods --kind Pod --schedule "0 * * * *"
+
+# Create a CleanupPolicy in the test namespace
+kyverno create cleanup-policy --name cleanup-pods --namespace test --kind Pod
+`
+
+func cleanupPolicyCommand() *cobra.Command {
+	var name, namespace, schedule, output string
+	var kinds []string
+	cmd := &cobra.Command{
+		Use:     "cleanup-policy",
+		Short:   "Creates a ClusterCleanupPolicy, or a CleanupPolicy if a namespace is given.",
+		Example: cleanupPolicyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if name == "" {
+				return errors.New("cleanup policy name is required")
+			}
+			if len(kinds) == 0 {
+				kinds = []string{"Pod"}
+			}
+			spec := kyvernov2alpha1.CleanupPolicySpec{
+				MatchResources: kyvernov2beta1.MatchResources{
+					Any: kyvernov1.ResourceFilters{{
+						ResourceDescription: kyvernov1.ResourceDescription{
+							Kinds: kinds,
+						},
+					}},
+				},
+				Schedule: schedule,
+			}
+			// cluster wide kinds can't be known without a cluster
+			clusterResources := sets.New[string]()
+			var policy runtime.Object
+			var errs field.ErrorList
+			if namespace != "" {
+				p := &kyvernov2alpha1.CleanupPolicy{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kyvernov2alpha1.SchemeGroupVersion.String(),
+						Kind:       "CleanupPolicy",
+					},
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec:       spec,
+				}
+				policy, errs = p, p.Validate(clusterResources)
+			} else {
+				p := &kyvernov2alpha1.ClusterCleanupPolicy{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kyvernov2alpha1.SchemeGroupVersion.String(),
+						Kind:       "ClusterCleanupPolicy",
+					},
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       spec,
+				}
+				policy, errs = p, p.Validate(clusterResources)
+			}
+			if len(errs) != 0 {
+				return fmt.Errorf("generated cleanup policy is not valid, %w", errs.ToAggregate())
+			}
+			return writeObject(policy, output)
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "", "", "Name of the cleanup policy")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace of the cleanup policy (creates a ClusterCleanupPolicy if empty)")
+	cmd.Flags().StringVarP(&schedule, "schedule", "s", "*/5 * * * *", "Schedule of the cleanup policy, in cron format")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", nil, "Kinds matched by the cleanup policy")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (defaults to stdout)")
+	return cmd
+}
@@ -0,0 +1,133 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+var description = []string{
+	"Helps with the creation of various Kyverno resources.",
+	"Generated manifests are validated before being written to the output.",
+}
+
+// Command returns create command
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: description[0],
+		Long:  strings.Join(description, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	cmd.AddCommand(policyCommand())
+	cmd.AddCommand(exceptionCommand())
+	cmd.AddCommand(cleanupPolicyCommand())
+	cmd.AddCommand(testCommand())
+	cmd.AddCommand(valuesCommand())
+	return cmd
+}
+
+// writeObject prints an object as yaml in the given file, or on stdout if no file is given
+func writeObject(obj runtime.Object, output string) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	// status and creation timestamp are not relevant in a manifest
+	delete(content, "status")
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+	pruneEmpty(content)
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return write(data, output)
+}
+
+// writeData prints a test or values file as yaml in the given file, or on stdout if no file is given.
+// Unset fields are omitted, user provided values are kept as is.
+func writeData(data interface{}, output string) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(data)
+	if err != nil {
+		return err
+	}
+	pruneUnset(content)
+	out, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return write(out, output)
+}
+
+func write(data []byte, output string) error {
+	if output == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+	return os.WriteFile(filepath.Clean(output), data, 0o600)
+}
+
+// pruneEmpty removes empty nested objects, they come from non pointer struct fields and only add noise
+func pruneEmpty(content map[string]interface{}) {
+	for key, value := range content {
+		switch nested := value.(type) {
+		case map[string]interface{}:
+			pruneEmpty(nested)
+			if len(nested) == 0 {
+				delete(content, key)
+			}
+		case []interface{}:
+			for _, item := range nested {
+				if item, ok := item.(map[string]interface{}); ok {
+					pruneEmpty(item)
+				}
+			}
+		}
+	}
+}
+
+// userValuesKeys hold values provided by the user, empty values are meaningful there and are not pruned
+var userValuesKeys = sets.New("globalValues", "values", "foreachValues", "labels")
+
+// pruneUnset removes nil values, empty strings, false booleans and empty collections
+func pruneUnset(content map[string]interface{}) {
+	for key, value := range content {
+		switch nested := value.(type) {
+		case nil:
+			delete(content, key)
+		case string:
+			if nested == "" {
+				delete(content, key)
+			}
+		case bool:
+			if !nested {
+				delete(content, key)
+			}
+		case map[string]interface{}:
+			if !userValuesKeys.Has(key) {
+				pruneUnset(nested)
+			}
+			if len(nested) == 0 {
+				delete(content, key)
+			}
+		case []interface{}:
+			for _, item := range nested {
+				if item, ok := item.(map[string]interface{}); ok {
+					pruneUnset(item)
+				}
+			}
+			if len(nested) == 0 {
+				delete(content, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package create
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_newRule(t *testing.T) {
+	for _, ruleType := range []string{ruleTypeValidate, ruleTypeMutate, ruleTypeGenerate, ruleTypeVerifyImages} {
+		rule, err := newRule("rule", ruleType, nil)
+		assert.NilError(t, err)
+		assert.Equal(t, rule.HasValidate(), ruleType == ruleTypeValidate)
+		assert.Equal(t, rule.HasMutate(), ruleType == ruleTypeMutate)
+		assert.Equal(t, rule.HasGenerate(), ruleType == ruleTypeGenerate)
+		assert.Equal(t, rule.HasVerifyImages(), ruleType == ruleTypeVerifyImages)
+	}
+	_, err := newRule("rule", "unknown", nil)
+	assert.ErrorContains(t, err, "unsupported rule type")
+}
+
+func Test_pruneEmpty(t *testing.T) {
+	content := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{
+					"name":     "rule",
+					"mutate":   map[string]interface{}{},
+					"generate": map[string]interface{}{"clone": map[string]interface{}{}},
+				},
+			},
+		},
+	}
+	pruneEmpty(content)
+	assert.DeepEqual(t, content, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{"name": "rule"},
+			},
+		},
+	})
+}
+
+func Test_parseNamedValues(t *testing.T) {
+	names, values, err := parseNamedValues("policy,resource,b=2,a=1", 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"policy", "resource"})
+	assert.DeepEqual(t, values, []keyValue{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}})
+	_, _, err = parseNamedValues("policy,a=1", 2)
+	assert.ErrorContains(t, err, "invalid entry")
+	_, err = parseTestResult("policy,rule,resource,Pod,unknown")
+	assert.ErrorContains(t, err, "invalid result")
+}
+
+func Test_pruneUnset(t *testing.T) {
+	content := map[string]interface{}{
+		"name":      "test",
+		"variables": "",
+		"results": []interface{}{
+			map[string]interface{}{"policy": "policy", "isVap": false, "status": ""},
+		},
+		"subresources": nil,
+		"globalValues": map[string]interface{}{"empty": ""},
+	}
+	pruneUnset(content)
+	assert.DeepEqual(t, content, map[string]interface{}{
+		"name": "test",
+		"results": []interface{}{
+			map[string]interface{}{"policy": "policy"},
+		},
+		"globalValues": map[string]interface{}{"empty": ""},
+	})
+}
+
+func Test_toMap(t *testing.T) {
+	assert.DeepEqual(t, toMap([]keyValue{{Key: "a", Value: "1"}, {Key: "b", Value: "true"}, {Key: "c", Value: "x: y"}}), map[string]interface{}{
+		"a": float64(1),
+		"b": true,
+		"c": "x: y",
+	})
+}
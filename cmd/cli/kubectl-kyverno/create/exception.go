@@ -0,0 +1,82 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	kyvernov2beta1 "github.com/kyverno/kyverno/api/kyverno/v2beta1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var exceptionExample = `
+# Create an exception for two rules of a policy, for pods in the test namespace
+kyverno create exception --name allow-test --namespace kyverno --policy-rules require-labels,check-team,check-app --kind Pod --match-namespace test
+`
+
+func exceptionCommand() *cobra.Command {
+	var name, namespace, output string
+	var policyRules, kinds, matchNamespaces []string
+	cmd := &cobra.Command{
+		Use:     "exception",
+		Short:   "Creates a PolicyException for the given policy rules.",
+		Example: exceptionExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if name == "" {
+				return errors.New("exception name is required")
+			}
+			if len(policyRules) == 0 {
+				return errors.New("at least one policy rules reference is required")
+			}
+			var exceptions []kyvernov2alpha1.Exception
+			for _, policyRule := range policyRules {
+				parts := strings.Split(policyRule, ",")
+				if len(parts) < 2 {
+					return fmt.Errorf("invalid policy rules reference %s, expected <policy>,<rule>[,<rule>...]", policyRule)
+				}
+				exceptions = append(exceptions, kyvernov2alpha1.Exception{
+					PolicyName: parts[0],
+					RuleNames:  parts[1:],
+				})
+			}
+			if len(kinds) == 0 {
+				kinds = []string{"Pod"}
+			}
+			exception := &kyvernov2alpha1.PolicyException{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kyvernov2alpha1.SchemeGroupVersion.String(),
+					Kind:       "PolicyException",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: kyvernov2alpha1.PolicyExceptionSpec{
+					Match: kyvernov2beta1.MatchResources{
+						Any: kyvernov1.ResourceFilters{{
+							ResourceDescription: kyvernov1.ResourceDescription{
+								Kinds:      kinds,
+								Namespaces: matchNamespaces,
+							},
+						}},
+					},
+					Exceptions: exceptions,
+				},
+			}
+			if errs := exception.Validate(); len(errs) != 0 {
+				return fmt.Errorf("generated exception is not valid, %w", errs.ToAggregate())
+			}
+			return writeObject(exception, output)
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "", "", "Name of the exception")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace of the exception")
+	cmd.Flags().StringArrayVarP(&policyRules, "policy-rules", "p", nil, "Policy and rules the exception applies to, in the form <policy>,<rule>[,<rule>...]")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", nil, "Kinds matched by the exception")
+	cmd.Flags().StringArrayVarP(&matchNamespaces, "match-namespace", "", nil, "Namespaces matched by the exception")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (defaults to stdout)")
+	return cmd
+}
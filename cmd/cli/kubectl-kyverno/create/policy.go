@@ -0,0 +1,158 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/openapi"
+	policyvalidation "github.com/kyverno/kyverno/pkg/validation/policy"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	ruleTypeValidate     = "validate"
+	ruleTypeMutate       = "mutate"
+	ruleTypeGenerate     = "generate"
+	ruleTypeVerifyImages = "verifyImages"
+)
+
+var policyExample = `
+# Create a validate policy for pods and deployments
+kyverno create policy --name require-labels --type validate --kind Pod --kind Deployment
+
+# Create a mutate policy and write it to a file
+kyverno create policy --name add-labels --type mutate --kind Pod --output add-labels.yaml
+`
+
+func policyCommand() *cobra.Command {
+	var name, ruleName, ruleType, action, output string
+	var kinds []string
+	var background bool
+	cmd := &cobra.Command{
+		Use:     "policy",
+		Short:   "Creates a ClusterPolicy with a rule of the given type.",
+		Example: policyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if name == "" {
+				return errors.New("policy name is required")
+			}
+			if ruleName == "" {
+				ruleName = ruleType
+			}
+			rule, err := newRule(ruleName, ruleType, kinds)
+			if err != nil {
+				return err
+			}
+			policy := &kyvernov1.ClusterPolicy{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kyvernov1.SchemeGroupVersion.String(),
+					Kind:       "ClusterPolicy",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: kyvernov1.Spec{
+					Background: &background,
+					Rules:      []kyvernov1.Rule{*rule},
+				},
+			}
+			if ruleType == ruleTypeValidate || ruleType == ruleTypeVerifyImages {
+				policy.Spec.ValidationFailureAction = kyvernov1.ValidationFailureAction(action)
+			}
+			if ruleType == ruleTypeVerifyImages {
+				// digests can only be mutated in enforce mode
+				policy.Spec.Rules[0].VerifyImages[0].MutateDigest = policy.Spec.ValidationFailureAction.Enforce()
+			}
+			openApiManager, err := openapi.NewManager(log.Log)
+			if err != nil {
+				return fmt.Errorf("unable to create open api controller, %w", err)
+			}
+			if _, err := policyvalidation.Validate(policy, nil, nil, true, openApiManager, config.KyvernoUserName(config.KyvernoServiceAccountName())); err != nil {
+				return fmt.Errorf("generated policy is not valid, %w", err)
+			}
+			return writeObject(policy, output)
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "", "", "Name of the policy")
+	cmd.Flags().StringVarP(&ruleName, "rule", "r", "", "Name of the rule (defaults to the rule type)")
+	cmd.Flags().StringVarP(&ruleType, "type", "t", ruleTypeValidate, "Type of the rule (validate, mutate, generate or verifyImages)")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", nil, "Kinds matched by the rule")
+	cmd.Flags().StringVarP(&action, "action", "a", string(kyvernov1.Audit), "Validation failure action (Audit or Enforce)")
+	cmd.Flags().BoolVarP(&background, "background", "b", true, "Enable background processing")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (defaults to stdout)")
+	return cmd
+}
+
+func newRule(name string, ruleType string, kinds []string) (*kyvernov1.Rule, error) {
+	if len(kinds) == 0 {
+		if ruleType == ruleTypeGenerate {
+			kinds = []string{"Namespace"}
+		} else {
+			kinds = []string{"Pod"}
+		}
+	}
+	rule := &kyvernov1.Rule{
+		Name: name,
+		MatchResources: kyvernov1.MatchResources{
+			Any: kyvernov1.ResourceFilters{{
+				ResourceDescription: kyvernov1.ResourceDescription{
+					Kinds: kinds,
+				},
+			}},
+		},
+	}
+	switch ruleType {
+	case ruleTypeValidate:
+		rule.Validation.Message = "The label `app.kubernetes.io/name` is required."
+		rule.Validation.SetPattern(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name": "?*",
+				},
+			},
+		})
+	case ruleTypeMutate:
+		rule.Mutation.SetPatchStrategicMerge(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"+(app.kubernetes.io/managed-by)": "kyverno",
+				},
+			},
+		})
+	case ruleTypeGenerate:
+		rule.Generation = kyvernov1.Generation{
+			ResourceSpec: kyvernov1.ResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "default-config",
+				Namespace:  "{{request.object.metadata.name}}",
+			},
+			Synchronize: true,
+		}
+		rule.Generation.SetData(map[string]interface{}{
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+		})
+	case ruleTypeVerifyImages:
+		rule.VerifyImages = []kyvernov1.ImageVerification{{
+			ImageReferences: []string{"*"},
+			VerifyDigest:    true,
+			Required:        true,
+			Attestors: []kyvernov1.AttestorSet{{
+				Entries: []kyvernov1.Attestor{{
+					Keys: &kyvernov1.StaticKeyAttestor{
+						PublicKeys: "-----BEGIN PUBLIC KEY-----\n<public key>\n-----END PUBLIC KEY-----",
+					},
+				}},
+			}},
+		}}
+	default:
+		return nil, fmt.Errorf("unsupported rule type %s", ruleType)
+	}
+	return rule, nil
+}
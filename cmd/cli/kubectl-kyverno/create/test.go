@@ -0,0 +1,68 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	policyreportv1alpha2 "github.com/kyverno/kyverno/api/policyreport/v1alpha2"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/test/api"
+	"github.com/spf13/cobra"
+)
+
+var testExample = `
+# Create a test file checking a pod against a rule
+kyverno create test --name require-labels --policy policy.yaml --resource resources.yaml --result require-labels,check-team,bad-pod,Pod,fail
+`
+
+func parseTestResult(in string) (api.TestResults, error) {
+	parts := strings.Split(in, ",")
+	if len(parts) != 5 {
+		return api.TestResults{}, fmt.Errorf("invalid result %s, expected <policy>,<rule>,<resource>,<kind>,<result>", in)
+	}
+	result := api.TestResults{
+		Policy:   parts[0],
+		Rule:     parts[1],
+		Resource: parts[2],
+		Kind:     parts[3],
+		Result:   policyreportv1alpha2.PolicyResult(parts[4]),
+	}
+	switch result.Result {
+	case policyreportv1alpha2.StatusPass, policyreportv1alpha2.StatusFail, policyreportv1alpha2.StatusWarn, policyreportv1alpha2.StatusError, policyreportv1alpha2.StatusSkip:
+	default:
+		return api.TestResults{}, fmt.Errorf("invalid result %s in %s, expected pass, fail, warn, error or skip", result.Result, in)
+	}
+	return result, nil
+}
+
+func testCommand() *cobra.Command {
+	var data api.Test
+	var results []string
+	var output string
+	cmd := &cobra.Command{
+		Use:     "test",
+		Short:   "Creates a kyverno test file.",
+		Example: testExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if data.Name == "" {
+				return errors.New("test name is required")
+			}
+			for _, in := range results {
+				result, err := parseTestResult(in)
+				if err != nil {
+					return err
+				}
+				data.Results = append(data.Results, result)
+			}
+			return writeData(&data, output)
+		},
+	}
+	cmd.Flags().StringVarP(&data.Name, "name", "", "", "Name of the test")
+	cmd.Flags().StringArrayVarP(&data.Policies, "policy", "p", nil, "Path to a policy file")
+	cmd.Flags().StringArrayVarP(&data.Resources, "resource", "r", nil, "Path to a resource file")
+	cmd.Flags().StringVarP(&data.Variables, "values", "f", "", "Path to the values file")
+	cmd.Flags().StringVarP(&data.UserInfo, "userinfo", "u", "", "Path to the user info file")
+	cmd.Flags().StringArrayVarP(&results, "result", "", nil, "Expected result, in the form <policy>,<rule>,<resource>,<kind>,<result>")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (defaults to stdout)")
+	return cmd
+}
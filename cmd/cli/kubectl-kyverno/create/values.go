@@ -0,0 +1,136 @@
+package create
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+var valuesExample = `
+# Create a values file with global values, per resource values and namespace labels
+kyverno create values --global request.operation=CREATE --resource require-labels,bad-pod,request.object.metadata.name=bad-pod --namespace-selector test,team=kyverno
+`
+
+type keyValue struct {
+	Key   string
+	Value string
+}
+
+// policy returns the values of the given policy, creating them if needed
+func policy(values *common.Values, name string) *common.Policy {
+	for i := range values.Policies {
+		if values.Policies[i].Name == name {
+			return &values.Policies[i]
+		}
+	}
+	values.Policies = append(values.Policies, common.Policy{Name: name})
+	return &values.Policies[len(values.Policies)-1]
+}
+
+// toMap returns the values as a map, numbers and booleans are typed, everything else is kept as a string
+func toMap(values []keyValue) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for _, kv := range values {
+		out[kv.Key] = kv.Value
+		var typed interface{}
+		if err := yaml.Unmarshal([]byte(kv.Value), &typed); err == nil {
+			switch typed.(type) {
+			case bool, float64:
+				out[kv.Key] = typed
+			}
+		}
+	}
+	return out
+}
+
+func parseKeyValues(in ...string) ([]keyValue, error) {
+	var values []keyValue
+	for _, kv := range in {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid value %s, expected <key>=<value>", kv)
+		}
+		values = append(values, keyValue{Key: key, Value: value})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Key < values[j].Key })
+	return values, nil
+}
+
+// parseNamedValues parses entries in the form <name>,<key>=<value>[,<key>=<value>...]
+func parseNamedValues(in string, minParts int) ([]string, []keyValue, error) {
+	parts := strings.Split(in, ",")
+	var names []string
+	for len(parts) > 0 && !strings.Contains(parts[0], "=") {
+		names = append(names, parts[0])
+		parts = parts[1:]
+	}
+	if len(names) != minParts || len(parts) == 0 {
+		return nil, nil, fmt.Errorf("invalid entry %s", in)
+	}
+	values, err := parseKeyValues(parts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return names, values, nil
+}
+
+func valuesCommand() *cobra.Command {
+	var globals, rules, resources, namespaceSelectors []string
+	var output string
+	cmd := &cobra.Command{
+		Use:     "values",
+		Short:   "Creates a values file for the apply and test commands.",
+		Example: valuesExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data common.Values
+			globalValues, err := parseKeyValues(globals...)
+			if err != nil {
+				return err
+			}
+			if len(globalValues) > 0 {
+				data.GlobalValues = map[string]string{}
+				for _, kv := range globalValues {
+					data.GlobalValues[kv.Key] = kv.Value
+				}
+			}
+			for _, in := range rules {
+				names, values, err := parseNamedValues(in, 2)
+				if err != nil {
+					return fmt.Errorf("%w, expected <policy>,<rule>,<key>=<value>[,<key>=<value>...]", err)
+				}
+				policyValues := policy(&data, names[0])
+				policyValues.Rules = append(policyValues.Rules, common.Rule{Name: names[1], Values: toMap(values)})
+			}
+			for _, in := range resources {
+				names, values, err := parseNamedValues(in, 2)
+				if err != nil {
+					return fmt.Errorf("%w, expected <policy>,<resource>,<key>=<value>[,<key>=<value>...]", err)
+				}
+				policyValues := policy(&data, names[0])
+				policyValues.Resources = append(policyValues.Resources, common.Resource{Name: names[1], Values: toMap(values)})
+			}
+			for _, in := range namespaceSelectors {
+				names, values, err := parseNamedValues(in, 1)
+				if err != nil {
+					return fmt.Errorf("%w, expected <namespace>,<label>=<value>[,<label>=<value>...]", err)
+				}
+				labels := map[string]string{}
+				for _, kv := range values {
+					labels[kv.Key] = kv.Value
+				}
+				data.NamespaceSelectors = append(data.NamespaceSelectors, common.NamespaceSelector{Name: names[0], Labels: labels})
+			}
+			return writeData(&data, output)
+		},
+	}
+	cmd.Flags().StringArrayVarP(&globals, "global", "g", nil, "Global value, in the form <key>=<value>")
+	cmd.Flags().StringArrayVarP(&rules, "rule", "", nil, "Rule values, in the form <policy>,<rule>,<key>=<value>[,<key>=<value>...]")
+	cmd.Flags().StringArrayVarP(&resources, "resource", "", nil, "Resource values, in the form <policy>,<resource>,<key>=<value>[,<key>=<value>...]")
+	cmd.Flags().StringArrayVarP(&namespaceSelectors, "namespace-selector", "", nil, "Namespace labels, in the form <namespace>,<label>=<value>[,<label>=<value>...]")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (defaults to stdout)")
+	return cmd
+}
@@ -0,0 +1,155 @@
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	sanitizederror "github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/sanitizedError"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+	"sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+var fixHelp = `
+To migrate deprecated syntax in policies and test files, rewriting files in place:
+        kyverno fix /path/to/policies /path/to/tests
+
+To only report files using deprecated syntax, failing if any is found (useful in CI):
+        kyverno fix /path/to/policies /path/to/tests --check
+
+The following deprecated syntax is migrated:
+  - validationFailureAction values in lowercase
+  - generateExistingOnPolicyUpdate in favor of generateExisting
+  - resources and user info directly under match or exclude, they are moved under any
+  - Equal and NotEqual condition operators, replaced by Equals and NotEquals
+  - image, key, issuer, subject, roots and annotations in verifyImages, converted to imageReferences and attestors
+  - status and resource in test results, replaced by result and resources
+`
+
+// Command returns fix command
+func Command() *cobra.Command {
+	var fileName string
+	var check bool
+	cmd := &cobra.Command{
+		Use:     "fix <path>...",
+		Short:   "Migrates deprecated syntax in policies and test files.",
+		Example: fixHelp,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, paths []string) (err error) {
+			defer func() {
+				if err != nil {
+					if !sanitizederror.IsErrorSanitized(err) {
+						log.Log.Error(err, "failed to sanitize")
+						err = fmt.Errorf("internal error")
+					}
+				}
+			}()
+			var fixed int
+			for _, path := range paths {
+				err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+					if err != nil {
+						return err
+					}
+					if d.IsDir() {
+						return nil
+					}
+					if ext := filepath.Ext(file); ext != ".yaml" && ext != ".yml" {
+						return nil
+					}
+					changed, err := fixFile(file, filepath.Base(file) == fileName, !check)
+					if err != nil {
+						return err
+					}
+					if changed {
+						fixed++
+					}
+					return nil
+				})
+				if err != nil {
+					return sanitizederror.NewWithError("failed to fix files", err)
+				}
+			}
+			if check {
+				if fixed > 0 {
+					return sanitizederror.NewWithError(fmt.Sprintf("%d files use deprecated syntax", fixed), fmt.Errorf("run kyverno fix to migrate them"))
+				}
+				fmt.Println("No deprecated syntax found")
+				return nil
+			}
+			fmt.Printf("Fixed %d files\n", fixed)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&fileName, "file-name", "f", "kyverno-test.yaml", "Name of the test files")
+	cmd.Flags().BoolVar(&check, "check", false, "Only report files using deprecated syntax, without rewriting them, and fail if any is found")
+	return cmd
+}
+
+// fixFile fixes the documents in a file, prints the changes and optionally rewrites the file
+func fixFile(file string, isTest bool, save bool) (bool, error) {
+	// We accept the risk of including a user provided file here.
+	data, err := os.ReadFile(filepath.Clean(file)) // #nosec G304
+	if err != nil {
+		return false, err
+	}
+	reader := kio.ByteReader{
+		Reader:                bytes.NewReader(data),
+		OmitReaderAnnotations: true,
+		DisableUnwrapping:     true,
+	}
+	nodes, err := reader.Read()
+	if err != nil {
+		if isTest {
+			return false, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		// not every yaml file is a kubernetes resource, files that can't be parsed are ignored
+		return false, nil
+	}
+	changes, err := fixNodes(nodes, isTest)
+	if err != nil {
+		return false, fmt.Errorf("failed to fix %s: %w", file, err)
+	}
+	if len(changes) == 0 {
+		return false, nil
+	}
+	for _, change := range changes {
+		fmt.Printf("%s: %s\n", file, change)
+	}
+	if save {
+		out, err := kio.StringAll(nodes)
+		if err != nil {
+			return false, err
+		}
+		if err := os.WriteFile(filepath.Clean(file), []byte(out), 0o600); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func fixNodes(nodes []*yaml.RNode, isTest bool) ([]string, error) {
+	var changes []string
+	for i, node := range nodes {
+		var fixes []string
+		var err error
+		if isTest {
+			fixes, err = fixTest(node)
+		} else if isPolicy(node) {
+			fixes, err = fixPolicy(node)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, fix := range fixes {
+			if len(nodes) > 1 {
+				fix = fmt.Sprintf("[%d] %s", i, fix)
+			}
+			changes = append(changes, fix)
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,281 @@
+package fix
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+)
+
+func Test_fixNodes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		isTest  bool
+		input   string
+		want    string
+		changes int
+	}{{
+		name:   "policy",
+		isTest: false,
+		input: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: enforce
+  generateExistingOnPolicyUpdate: true
+  rules:
+  - name: rule
+    match:
+      resources:
+        kinds:
+        - Pod
+    exclude:
+      clusterRoles:
+      - admin
+    preconditions:
+      any:
+      - key: '{{ request.operation }}'
+        operator: NotEqual
+        value: DELETE
+    verifyImages:
+    - image: ghcr.io/*
+      issuer: https://token.actions.githubusercontent.com
+      subject: https://github.com/kyverno/*
+      mutateDigest: false
+`,
+		want: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Enforce
+  generateExisting: true
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    exclude:
+      any:
+      - clusterRoles:
+        - admin
+    preconditions:
+      any:
+      - key: '{{ request.operation }}'
+        operator: NotEquals
+        value: DELETE
+    verifyImages:
+    - imageReferences:
+      - ghcr.io/*
+      attestors:
+      - entries:
+        - keyless:
+            issuer: https://token.actions.githubusercontent.com
+            subject: https://github.com/kyverno/*
+      mutateDigest: false
+`,
+		changes: 6,
+	}, {
+		name:   "image verification with comments",
+		isTest: false,
+		input: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    verifyImages:
+    # signed images
+    - verifyDigest: false
+      image: ghcr.io/* # all images
+      key: |-
+        -----BEGIN PUBLIC KEY-----
+        MFkw
+        -----END PUBLIC KEY-----
+      annotations:
+        team: a
+      required: true
+`,
+		want: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    verifyImages:
+    # signed images
+    - verifyDigest: false
+      imageReferences:
+      - ghcr.io/* # all images
+      attestors:
+      - entries:
+        - annotations:
+            team: a
+          keys:
+            publicKeys: |-
+              -----BEGIN PUBLIC KEY-----
+              MFkw
+              -----END PUBLIC KEY-----
+      required: true
+`,
+		changes: 1,
+	}, {
+		name:   "image verification with attestations",
+		isTest: false,
+		input: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    verifyImages:
+    - image: ghcr.io/*
+      issuer: https://token.actions.githubusercontent.com
+      subject: https://github.com/kyverno/*
+      attestations:
+      - predicateType: https://slsa.dev/provenance/v0.2
+      - predicateType: https://cyclonedx.org/bom
+        attestors:
+        - entries:
+          - keys:
+              publicKeys: k8s://kyverno/sbom
+`,
+		want: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+    verifyImages:
+    - imageReferences:
+      - ghcr.io/*
+      attestations:
+      - predicateType: https://slsa.dev/provenance/v0.2
+        attestors:
+        - entries:
+          - keyless:
+              issuer: https://token.actions.githubusercontent.com
+              subject: https://github.com/kyverno/*
+      - predicateType: https://cyclonedx.org/bom
+        attestors:
+        - entries:
+          - keys:
+              publicKeys: k8s://kyverno/sbom
+        - entries:
+          - keyless:
+              issuer: https://token.actions.githubusercontent.com
+              subject: https://github.com/kyverno/*
+`,
+		changes: 1,
+	}, {
+		name:   "up to date policy",
+		isTest: false,
+		input: `apiVersion: kyverno.io/v1
+kind: ClusterPolicy
+metadata:
+  name: policy
+spec:
+  validationFailureAction: Audit
+  rules:
+  - name: rule
+    match:
+      any:
+      - resources:
+          kinds:
+          - Pod
+`,
+		changes: 0,
+	}, {
+		name:   "not a policy",
+		isTest: false,
+		input: `apiVersion: v1
+kind: Pod
+metadata:
+  name: pod
+spec:
+  validationFailureAction: enforce
+`,
+		changes: 0,
+	}, {
+		name:   "test",
+		isTest: true,
+		input: `name: test
+results:
+- policy: policy
+  rule: rule
+  resource: foo
+  kind: Pod
+  status: pass
+- policy: policy
+  rule: rule
+  resource: bar
+  resources:
+  - foo
+  kind: Pod
+  result: fail
+  status: fail
+`,
+		want: `name: test
+results:
+- policy: policy
+  rule: rule
+  resources:
+  - foo
+  kind: Pod
+  result: pass
+- policy: policy
+  rule: rule
+  resources:
+  - foo
+  - bar
+  kind: Pod
+  result: fail
+`,
+		changes: 4,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nodes, err := kio.FromBytes([]byte(tc.input))
+			assert.NilError(t, err)
+			changes, err := fixNodes(nodes, tc.isTest)
+			assert.NilError(t, err)
+			assert.Equal(t, len(changes), tc.changes)
+			if tc.want != "" {
+				out, err := kio.StringAll(nodes)
+				assert.NilError(t, err)
+				assert.Equal(t, out, tc.want)
+			}
+		})
+	}
+}
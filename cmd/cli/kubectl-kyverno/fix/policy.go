@@ -0,0 +1,309 @@
+package fix
+
+import (
+	"fmt"
+	"strings"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// legacyMatchFields are the fields that can be set directly under match and exclude,
+// they should be set in a resource filter under any or all instead
+var legacyMatchFields = []string{"resources", "subjects", "roles", "clusterRoles"}
+
+// deprecatedOperators maps deprecated condition operators to their replacement
+var deprecatedOperators = map[string]string{
+	"Equal":    "Equals",
+	"NotEqual": "NotEquals",
+}
+
+func isPolicy(node *yaml.RNode) bool {
+	kind := node.GetKind()
+	return strings.HasPrefix(node.GetApiVersion(), kyvernov1.SchemeGroupVersion.Group+"/") && (kind == "ClusterPolicy" || kind == "Policy")
+}
+
+// fixPolicy migrates deprecated syntax in a policy and returns the list of changes
+func fixPolicy(node *yaml.RNode) ([]string, error) {
+	var changes []string
+	spec := node.Field("spec")
+	if spec == nil {
+		return nil, nil
+	}
+	if fixAction(spec.Value, "validationFailureAction") {
+		changes = append(changes, "spec.validationFailureAction: fixed casing")
+	}
+	if overrides := spec.Value.Field("validationFailureActionOverrides"); overrides != nil {
+		elements, err := overrides.Value.Elements()
+		if err != nil {
+			return nil, err
+		}
+		for i, override := range elements {
+			if fixAction(override, "action") {
+				changes = append(changes, fmt.Sprintf("spec.validationFailureActionOverrides[%d].action: fixed casing", i))
+			}
+		}
+	}
+	if deprecated := spec.Value.Field("generateExistingOnPolicyUpdate"); deprecated != nil {
+		if spec.Value.Field("generateExisting") == nil {
+			renameField(spec.Value, "generateExistingOnPolicyUpdate", "generateExisting")
+			changes = append(changes, "spec.generateExistingOnPolicyUpdate: renamed to generateExisting")
+		} else {
+			if err := spec.Value.PipeE(yaml.Clear("generateExistingOnPolicyUpdate")); err != nil {
+				return nil, err
+			}
+			changes = append(changes, "spec.generateExistingOnPolicyUpdate: removed in favor of generateExisting")
+		}
+	}
+	rules := spec.Value.Field("rules")
+	if rules == nil {
+		return changes, nil
+	}
+	elements, err := rules.Value.Elements()
+	if err != nil {
+		return nil, err
+	}
+	for i, rule := range elements {
+		path := fmt.Sprintf("spec.rules[%d]", i)
+		for _, field := range []string{"match", "exclude"} {
+			fixed, err := fixMatch(rule, field)
+			if err != nil {
+				return nil, err
+			}
+			if fixed {
+				changes = append(changes, fmt.Sprintf("%s.%s: moved resource description and user info under any", path, field))
+			}
+		}
+		if count := fixOperators(rule); count > 0 {
+			changes = append(changes, fmt.Sprintf("%s: replaced %d deprecated condition operators", path, count))
+		}
+		if verifyImages := rule.Field("verifyImages"); verifyImages != nil {
+			elements, err := verifyImages.Value.Elements()
+			if err != nil {
+				return nil, err
+			}
+			for j, imageVerification := range elements {
+				fixed, err := fixImageVerification(imageVerification)
+				if err != nil {
+					return nil, fmt.Errorf("%s.verifyImages[%d]: %w", path, j, err)
+				}
+				if fixed {
+					changes = append(changes, fmt.Sprintf("%s.verifyImages[%d]: converted deprecated fields to imageReferences and attestors", path, j))
+				}
+			}
+		}
+	}
+	return changes, nil
+}
+
+// fixAction fixes the casing of a validation failure action
+func fixAction(node *yaml.RNode, field string) bool {
+	action := node.Field(field)
+	if action == nil || action.Value.YNode().Kind != yaml.ScalarNode {
+		return false
+	}
+	var fixed kyvernov1.ValidationFailureAction
+	switch value := kyvernov1.ValidationFailureAction(action.Value.YNode().Value); {
+	case value == kyvernov1.Enforce || value == kyvernov1.Audit || !value.IsValid():
+		return false
+	case value.Enforce():
+		fixed = kyvernov1.Enforce
+	default:
+		fixed = kyvernov1.Audit
+	}
+	action.Value.YNode().Value = string(fixed)
+	return true
+}
+
+// fixMatch moves the legacy fields of a match or exclude block to a resource filter under any
+func fixMatch(rule *yaml.RNode, field string) (bool, error) {
+	match := rule.Field(field)
+	if match == nil || match.Value.Field("any") != nil || match.Value.Field("all") != nil {
+		return false, nil
+	}
+	filter := yaml.NewMapRNode(nil)
+	for _, name := range legacyMatchFields {
+		if value := match.Value.Field(name); value != nil {
+			if err := filter.PipeE(yaml.SetField(name, value.Value)); err != nil {
+				return false, err
+			}
+			if err := match.Value.PipeE(yaml.Clear(name)); err != nil {
+				return false, err
+			}
+		}
+	}
+	if len(filter.Content()) == 0 {
+		return false, nil
+	}
+	filters := yaml.NewListRNode()
+	if err := filters.PipeE(yaml.Append(filter.YNode())); err != nil {
+		return false, err
+	}
+	return true, match.Value.PipeE(yaml.SetField("any", filters))
+}
+
+// fixOperators replaces deprecated operators in all the conditions found under the given node
+func fixOperators(node *yaml.RNode) int {
+	count := 0
+	var walk func(*yaml.Node)
+	walk = func(node *yaml.Node) {
+		if node.Kind == yaml.MappingNode {
+			var hasKey bool
+			var operator *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				switch node.Content[i].Value {
+				case "key":
+					hasKey = true
+				case "operator":
+					operator = node.Content[i+1]
+				}
+			}
+			if hasKey && operator != nil {
+				if replacement, ok := deprecatedOperators[operator.Value]; ok {
+					operator.Value = replacement
+					count++
+				}
+			}
+		}
+		for _, child := range node.Content {
+			walk(child)
+		}
+	}
+	walk(node.YNode())
+	return count
+}
+
+// fixImageVerification converts the deprecated fields of an image verification in place,
+// image is moved to imageReferences and the key or keyless fields are moved to a new attestor
+func fixImageVerification(node *yaml.RNode) (bool, error) {
+	image, key, issuer := node.Field("image"), node.Field("key"), node.Field("issuer")
+	if image == nil && key == nil && issuer == nil {
+		return false, nil
+	}
+	annotations := node.Field("annotations")
+	if image != nil {
+		if references := node.Field("imageReferences"); references != nil {
+			if err := references.Value.PipeE(yaml.Append(image.Value.YNode())); err != nil {
+				return false, err
+			}
+			if err := node.PipeE(yaml.Clear("image")); err != nil {
+				return false, err
+			}
+		} else {
+			references := yaml.NewListRNode()
+			if err := references.PipeE(yaml.Append(image.Value.YNode())); err != nil {
+				return false, err
+			}
+			replaceField(node, "image", "imageReferences", references.YNode())
+		}
+	}
+	if key == nil && issuer == nil && annotations == nil {
+		return true, nil
+	}
+	attestor := yaml.NewMapRNode(nil)
+	if annotations != nil {
+		if err := attestor.PipeE(yaml.SetField("annotations", annotations.Value)); err != nil {
+			return false, err
+		}
+	}
+	if key != nil {
+		keys := yaml.NewMapRNode(nil)
+		if err := keys.PipeE(yaml.SetField("publicKeys", key.Value)); err != nil {
+			return false, err
+		}
+		if err := attestor.PipeE(yaml.SetField("keys", keys)); err != nil {
+			return false, err
+		}
+	} else if issuer != nil {
+		keyless := yaml.NewMapRNode(nil)
+		for _, field := range []string{"issuer", "subject", "roots", "additionalExtensions"} {
+			if value := node.Field(field); value != nil {
+				if err := keyless.PipeE(yaml.SetField(field, value.Value)); err != nil {
+					return false, err
+				}
+			}
+		}
+		if err := attestor.PipeE(yaml.SetField("keyless", keyless)); err != nil {
+			return false, err
+		}
+		if err := node.PipeE(yaml.Clear("additionalExtensions")); err != nil {
+			return false, err
+		}
+	}
+	entries := yaml.NewListRNode()
+	if err := entries.PipeE(yaml.Append(attestor.YNode())); err != nil {
+		return false, err
+	}
+	attestorSet := yaml.NewMapRNode(nil)
+	if err := attestorSet.PipeE(yaml.SetField("entries", entries)); err != nil {
+		return false, err
+	}
+	// the attestor set takes the place of the first deprecated field unless attestors are already set,
+	// when attestations are used it is added to each attestation instead
+	legacyFields := []string{"key", "issuer", "subject", "roots", "annotations"}
+	if attestations := node.Field("attestations"); attestations != nil {
+		elements, err := attestations.Value.Elements()
+		if err != nil {
+			return false, err
+		}
+		for _, attestation := range elements {
+			if attestors := attestation.Field("attestors"); attestors != nil {
+				if err := attestors.Value.PipeE(yaml.Append(attestorSet.Copy().YNode())); err != nil {
+					return false, err
+				}
+			} else {
+				attestors := yaml.NewListRNode()
+				if err := attestors.PipeE(yaml.Append(attestorSet.Copy().YNode())); err != nil {
+					return false, err
+				}
+				if err := attestation.PipeE(yaml.SetField("attestors", attestors)); err != nil {
+					return false, err
+				}
+			}
+		}
+	} else if attestors := node.Field("attestors"); attestors != nil {
+		if err := attestors.Value.PipeE(yaml.Append(attestorSet.YNode())); err != nil {
+			return false, err
+		}
+	} else {
+		attestors := yaml.NewListRNode()
+		if err := attestors.PipeE(yaml.Append(attestorSet.YNode())); err != nil {
+			return false, err
+		}
+		for _, field := range legacyFields {
+			if replaceField(node, field, "attestors", attestors.YNode()) {
+				break
+			}
+		}
+	}
+	for _, field := range legacyFields {
+		if err := node.PipeE(yaml.Clear(field)); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// replaceField renames a field of a mapping node and replaces its value, keeping its position and comments
+func replaceField(node *yaml.RNode, from, to string, value *yaml.Node) bool {
+	content := node.Content()
+	for i := 0; i < len(content); i += 2 {
+		if content[i].Value == from {
+			content[i].Value = to
+			content[i+1] = value
+			return true
+		}
+	}
+	return false
+}
+
+// renameField renames a field of a mapping node, keeping its position and comments
+func renameField(node *yaml.RNode, from, to string) {
+	content := node.Content()
+	for i := 0; i < len(content); i += 2 {
+		if content[i].Value == from {
+			content[i].Value = to
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package fix
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+// fixTest migrates deprecated syntax in a test file and returns the list of changes
+func fixTest(node *yaml.RNode) ([]string, error) {
+	var changes []string
+	results := node.Field("results")
+	if results == nil {
+		return nil, nil
+	}
+	elements, err := results.Value.Elements()
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range elements {
+		path := fmt.Sprintf("results[%d]", i)
+		if result.Field("status") != nil {
+			if result.Field("result") == nil {
+				renameField(result, "status", "result")
+				changes = append(changes, path+".status: renamed to result")
+			} else {
+				if err := result.PipeE(yaml.Clear("status")); err != nil {
+					return nil, err
+				}
+				changes = append(changes, path+".status: removed in favor of result")
+			}
+		}
+		if resource := result.Field("resource"); resource != nil {
+			resources := result.Field("resources")
+			if resources == nil {
+				renameField(result, "resource", "resources")
+				// copy the scalar, its node is replaced by the list
+				value := *resource.Value.YNode()
+				list := yaml.NewListRNode()
+				if err := list.PipeE(yaml.Append(&value)); err != nil {
+					return nil, err
+				}
+				resource.Value.SetYNode(list.YNode())
+			} else {
+				if err := resources.Value.PipeE(yaml.Append(resource.Value.YNode())); err != nil {
+					return nil, err
+				}
+				if err := result.PipeE(yaml.Clear("resource")); err != nil {
+					return nil, err
+				}
+			}
+			changes = append(changes, path+".resource: moved to resources")
+		}
+	}
+	return changes, nil
+}
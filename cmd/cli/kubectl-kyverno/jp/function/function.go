@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
 	"github.com/spf13/cobra"
@@ -19,19 +20,27 @@ var description = []string{
 var examples = []string{
 	"  # List functions    \n  kyverno jp function",
 	"  # Get function infos\n  kyverno jp function <function name>",
+	"  # List functions including user defined functions\n  kyverno jp function -f functions.yaml",
 }
 
 func Command() *cobra.Command {
-	return &cobra.Command{
+	var functionFiles []string
+	cmd := &cobra.Command{
 		Use:          "function [function_name]...",
 		Short:        description[0],
 		Long:         strings.Join(description, "\n"),
 		Example:      strings.Join(examples, "\n\n"),
 		SilenceUsage: true,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := common.LoadJMESPathFunctions(functionFiles); err != nil {
+				return err
+			}
 			printFunctions(args...)
+			return nil
 		},
 	}
+	cmd.Flags().StringSliceVarP(&functionFiles, "functions", "f", nil, "Load user defined functions from JMESPathFunction manifests")
+	return cmd
 }
 
 func printFunctions(names ...string) {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	gojmespath "github.com/jmespath/go-jmespath"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
 	"github.com/spf13/cobra"
@@ -27,13 +28,14 @@ var examples = []string{
 	"  # Evaluate multiple queries  \n  kyverno jp query -i object.yaml -q query-file-1 -q query-file-2 'request.object.metadata.name | truncate(@, `9`)'",
 	"  # Cat query into             \n  cat query-file | kyverno jp query -i object.yaml",
 	"  # Cat object into            \n  cat object.yaml | kyverno jp query -q query-file",
+	"  # Use user defined functions \n  kyverno jp query -i object.yaml -f functions.yaml 'registry_host(request.object.spec.containers[0].image)'",
 }
 
 // Command returns jp command
 func Command() *cobra.Command {
 	var compact, unquoted bool
 	var input string
-	var queries []string
+	var queries, functionFiles []string
 	cmd := &cobra.Command{
 		Use:          "query [-i input] [-q query|query]...",
 		Short:        description[0],
@@ -41,6 +43,9 @@ func Command() *cobra.Command {
 		SilenceUsage: true,
 		Example:      strings.Join(examples, "\n\n"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := common.LoadJMESPathFunctions(functionFiles); err != nil {
+				return err
+			}
 			queries, err := loadQueries(args, queries)
 			if err != nil {
 				return err
@@ -82,6 +87,7 @@ func Command() *cobra.Command {
 	cmd.Flags().BoolVarP(&unquoted, "unquoted", "u", false, "If the final result is a string, it will be printed without quotes")
 	cmd.Flags().StringSliceVarP(&queries, "query", "q", nil, "Read JMESPath expression from the specified file")
 	cmd.Flags().StringVarP(&input, "input", "i", "", "Read input from a JSON or YAML file instead of stdin")
+	cmd.Flags().StringSliceVarP(&functionFiles, "functions", "f", nil, "Load user defined functions from JMESPathFunction manifests")
 	return cmd
 }
 
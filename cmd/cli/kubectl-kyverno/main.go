@@ -6,8 +6,11 @@ import (
 	"strconv"
 
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/apply"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/create"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/fix"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/jp"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/oci"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/snapshot"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/test"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/version"
 	"github.com/kyverno/kyverno/pkg/logging"
@@ -48,7 +51,7 @@ func enableExperimental() bool {
 }
 
 func registerCommands(cli *cobra.Command) {
-	cli.AddCommand(version.Command(), apply.Command(), test.Command(), jp.Command())
+	cli.AddCommand(version.Command(), apply.Command(), test.Command(), jp.Command(), snapshot.Command(), create.Command(), fix.Command())
 	if enableExperimental() {
 		cli.AddCommand(oci.Command())
 	}
@@ -0,0 +1,93 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
+	sanitizederror "github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/sanitizedError"
+	snapshotutils "github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/snapshot"
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var snapshotHelp = `
+To export the resources matched by policies, all namespaces and configmaps:
+        kyverno snapshot /path/to/policy.yaml /path/to/folderOfPolicies --output snapshot.yaml
+
+To include additional kinds, for example the ones used by apiCall context entries:
+        kyverno snapshot /path/to/policy.yaml --kind Service --kind networking.k8s.io/v1/Ingress --output snapshot.yaml
+
+To apply policies or run tests against the snapshot:
+        kyverno apply /path/to/policy.yaml --snapshot snapshot.yaml
+        kyverno test /path/to/tests --snapshot snapshot.yaml
+`
+
+// Command returns snapshot command
+func Command() *cobra.Command {
+	var kubeConfig, kubeContext, namespace, output string
+	var kinds []string
+	cmd := &cobra.Command{
+		Use:     "snapshot",
+		Short:   "Exports a cluster snapshot that can be used to apply policies without cluster access.",
+		Example: snapshotHelp,
+		RunE: func(cmd *cobra.Command, policyPaths []string) (err error) {
+			defer func() {
+				if err != nil {
+					if !sanitizederror.IsErrorSanitized(err) {
+						log.Log.Error(err, "failed to sanitize")
+						err = fmt.Errorf("internal error")
+					}
+				}
+			}()
+			restConfig, err := config.CreateClientConfigWithContext(kubeConfig, kubeContext)
+			if err != nil {
+				return sanitizederror.NewWithError("failed to create client config", err)
+			}
+			kubeClient, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return sanitizederror.NewWithError("failed to create kube client", err)
+			}
+			dynamicClient, err := dynamic.NewForConfig(restConfig)
+			if err != nil {
+				return sanitizederror.NewWithError("failed to create dynamic client", err)
+			}
+			dClient, err := dclient.NewClient(context.Background(), dynamicClient, kubeClient, 15*time.Minute)
+			if err != nil {
+				return sanitizederror.NewWithError("failed to create client", err)
+			}
+			s, err := snapshotutils.Export(context.Background(), dClient, namespace, kinds...)
+			if err != nil {
+				return sanitizederror.NewWithError("failed to export snapshot", err)
+			}
+			if len(policyPaths) > 0 {
+				policies, validatingAdmissionPolicies, err := common.GetPoliciesFromPaths(memfs.New(), policyPaths, false, "")
+				if err != nil {
+					return err
+				}
+				resources, err := common.GetResources(policies, validatingAdmissionPolicies, nil, dClient, true, namespace, true)
+				if err != nil {
+					return sanitizederror.NewWithError("failed to fetch resources", err)
+				}
+				s.Add(resources...)
+			}
+			if err := s.Write(output); err != nil {
+				return sanitizederror.NewWithError("failed to write snapshot", err)
+			}
+			fmt.Printf("Exported %d resources to %s\n", len(s.Resources), output)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "snapshot.yaml", "Path of the snapshot file")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", nil, "Additional kinds to include in the snapshot")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Only export namespaced resources from this namespace")
+	cmd.Flags().StringVarP(&kubeConfig, "kubeconfig", "", "", "path to kubeconfig file with authorization and master location information")
+	cmd.Flags().StringVarP(&kubeContext, "context", "", "", "The name of the kubeconfig context to use")
+	return cmd
+}
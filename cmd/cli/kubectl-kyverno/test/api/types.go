@@ -30,6 +30,7 @@ type TestResults struct {
 	Result policyreportv1alpha2.PolicyResult `json:"result"`
 	// Status mentions the status that the user is expecting.
 	// Possible values are pass, fail and skip.
+	// Deprecated. Use Result instead.
 	Status policyreportv1alpha2.PolicyResult `json:"status"`
 	// Resource mentions the name of the resource on which the policy is to be applied.
 	Resource string `json:"resource"`
@@ -0,0 +1,173 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/autogen"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+)
+
+// RuleCoverage contains the outcomes observed for a single rule
+type RuleCoverage struct {
+	Policy   string                 `json:"policy"`
+	Rule     string                 `json:"rule"`
+	Outcomes []engineapi.RuleStatus `json:"outcomes,omitempty"`
+}
+
+// Tested returns true if the rule produced at least one pass or fail outcome
+func (r RuleCoverage) Tested() bool {
+	return r.has(engineapi.RuleStatusPass) || r.has(engineapi.RuleStatusFail)
+}
+
+// Covered returns true if the rule produced both pass and fail outcomes
+func (r RuleCoverage) Covered() bool {
+	return r.has(engineapi.RuleStatusPass) && r.has(engineapi.RuleStatusFail)
+}
+
+func (r RuleCoverage) has(status engineapi.RuleStatus) bool {
+	for _, outcome := range r.Outcomes {
+		if outcome == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CoverageReport is the coverage summary written by the test command
+type CoverageReport struct {
+	Rules         int            `json:"rules"`
+	Covered       int            `json:"covered"`
+	Percentage    float64        `json:"percentage"`
+	Untested      []RuleCoverage `json:"untested"`
+	SingleOutcome []RuleCoverage `json:"singleOutcome"`
+}
+
+type coverage struct {
+	// rules maps a policy key to its rules and the outcomes observed for each rule
+	rules map[string]map[string]map[engineapi.RuleStatus]struct{}
+}
+
+func newCoverage() *coverage {
+	return &coverage{
+		rules: map[string]map[string]map[engineapi.RuleStatus]struct{}{},
+	}
+}
+
+func coveragePolicyKey(namespace, name string) string {
+	if namespace != "" {
+		return namespace + "/" + name
+	}
+	return name
+}
+
+// addPolicy registers all rules of a policy, including autogen rules
+func (c *coverage) addPolicy(policy kyvernov1.PolicyInterface) {
+	if c == nil {
+		return
+	}
+	key := coveragePolicyKey(policy.GetNamespace(), policy.GetName())
+	rules, ok := c.rules[key]
+	if !ok {
+		rules = map[string]map[engineapi.RuleStatus]struct{}{}
+		c.rules[key] = rules
+	}
+	for _, rule := range autogen.ComputeRules(policy) {
+		if _, ok := rules[rule.Name]; !ok {
+			rules[rule.Name] = map[engineapi.RuleStatus]struct{}{}
+		}
+	}
+}
+
+// addResponses records the rule outcomes found in engine responses
+func (c *coverage) addResponses(responses ...engineapi.EngineResponse) {
+	if c == nil {
+		return
+	}
+	for _, response := range responses {
+		if response.IsValidatingAdmissionPolicy() {
+			continue
+		}
+		policy := response.Policy()
+		rules, ok := c.rules[coveragePolicyKey(policy.GetNamespace(), policy.GetName())]
+		if !ok {
+			continue
+		}
+		for _, rule := range response.PolicyResponse.Rules {
+			outcomes, ok := rules[rule.Name()]
+			if !ok {
+				outcomes = map[engineapi.RuleStatus]struct{}{}
+				rules[rule.Name()] = outcomes
+			}
+			outcomes[rule.Status()] = struct{}{}
+		}
+	}
+}
+
+func (c *coverage) report() CoverageReport {
+	var report CoverageReport
+	var policies []string
+	for policy := range c.rules {
+		policies = append(policies, policy)
+	}
+	sort.Strings(policies)
+	for _, policy := range policies {
+		var rules []string
+		for rule := range c.rules[policy] {
+			rules = append(rules, rule)
+		}
+		sort.Strings(rules)
+		for _, rule := range rules {
+			ruleCoverage := RuleCoverage{
+				Policy: policy,
+				Rule:   rule,
+			}
+			for outcome := range c.rules[policy][rule] {
+				ruleCoverage.Outcomes = append(ruleCoverage.Outcomes, outcome)
+			}
+			sort.Slice(ruleCoverage.Outcomes, func(i, j int) bool {
+				return ruleCoverage.Outcomes[i] < ruleCoverage.Outcomes[j]
+			})
+			report.Rules++
+			if ruleCoverage.Covered() {
+				report.Covered++
+			} else if ruleCoverage.Tested() {
+				report.SingleOutcome = append(report.SingleOutcome, ruleCoverage)
+			} else {
+				report.Untested = append(report.Untested, ruleCoverage)
+			}
+		}
+	}
+	if report.Rules > 0 {
+		report.Percentage = float64(report.Covered) * 100 / float64(report.Rules)
+	}
+	return report
+}
+
+func printCoverage(report CoverageReport) {
+	fmt.Printf("\nCoverage Summary: %d out of %d rules covered (%.2f%%)\n", report.Covered, report.Rules, report.Percentage)
+	if len(report.Untested) > 0 {
+		fmt.Println("\nUntested rules:")
+		for _, rule := range report.Untested {
+			fmt.Printf("    %s/%s\n", rule.Policy, rule.Rule)
+		}
+	}
+	if len(report.SingleOutcome) > 0 {
+		fmt.Println("\nRules with a single outcome:")
+		for _, rule := range report.SingleOutcome {
+			fmt.Printf("    %s/%s %v\n", rule.Policy, rule.Rule, rule.Outcomes)
+		}
+	}
+	fmt.Println()
+}
+
+func writeCoverage(report CoverageReport, path string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_coverage(t *testing.T) {
+	policy := &kyvernov1.ClusterPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "policy",
+			Annotations: map[string]string{
+				kyvernov1.PodControllersAnnotation: "none",
+			},
+		},
+		Spec: kyvernov1.Spec{
+			Rules: []kyvernov1.Rule{{Name: "both"}, {Name: "single"}, {Name: "untested"}},
+		},
+	}
+	cov := newCoverage()
+	cov.addPolicy(policy)
+	response := func(rules ...engineapi.RuleResponse) engineapi.EngineResponse {
+		return engineapi.EngineResponse{
+			PolicyResponse: engineapi.PolicyResponse{Rules: rules},
+		}.WithPolicy(policy)
+	}
+	cov.addResponses(
+		response(
+			*engineapi.RulePass("both", engineapi.Validation, ""),
+			*engineapi.RulePass("single", engineapi.Validation, ""),
+			*engineapi.RuleSkip("untested", engineapi.Validation, ""),
+		),
+		response(
+			*engineapi.RuleFail("both", engineapi.Validation, ""),
+			*engineapi.RulePass("single", engineapi.Validation, ""),
+		),
+	)
+	report := cov.report()
+	assert.Equal(t, report.Rules, 3)
+	assert.Equal(t, report.Covered, 1)
+	assert.Equal(t, len(report.SingleOutcome), 1)
+	assert.Equal(t, report.SingleOutcome[0].Rule, "single")
+	assert.Equal(t, len(report.Untested), 1)
+	assert.Equal(t, report.Untested[0].Rule, "untested")
+}
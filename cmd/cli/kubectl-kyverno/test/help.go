@@ -120,5 +120,14 @@ pass  --> The resource is either validated by the policy or, if a mutation, equa
 fail  --> The resource fails validation or the patched resource generated by Kyverno is not equal to the input resource provided by the user.
 skip  --> The rule is not applied.
 
+**COVERAGE**:
+
+When the --coverage flag is set, the test command reports, for all rules of the tested policies (including autogen rules):
+- untested rules, which never produced a pass or fail result
+- rules with a single outcome, which produced either pass or fail results but not both
+- the percentage of rules that produced both pass and fail results
+
+Use --coverage-output to also write the coverage report as JSON, for example to gate CI pipelines (--coverage is implied).
+
 For more information visit https://kyverno.io/docs/kyverno-cli/#test
 `
@@ -37,11 +37,12 @@ func applyPoliciesFromPath(
 	policyResourcePath string,
 	rc *resultCounts,
 	openApiManager openapi.Manager,
+	dClient dclient.Interface,
 	filter filter,
 	auditWarn bool,
+	cov *coverage,
 ) (map[string]policyreportv1alpha2.PolicyReportResult, []api.TestResults, error) {
 	engineResponses := make([]engineapi.EngineResponse, 0)
-	var dClient dclient.Interface
 	values := &api.Test{}
 	var variablesString string
 	var resultCounts common.ResultCounts
@@ -111,6 +112,7 @@ func applyPoliciesFromPath(
 
 	var filteredPolicies []kyvernov1.PolicyInterface
 	for _, p := range policies {
+		cov.addPolicy(p)
 		for _, res := range values.Results {
 			if p.GetName() == res.Policy {
 				filteredPolicies = append(filteredPolicies, p)
@@ -259,6 +261,7 @@ func applyPoliciesFromPath(
 			engineResponses = append(engineResponses, ers...)
 		}
 	}
+	cov.addResponses(engineResponses...)
 	resultsMap, testResults := buildPolicyResults(engineResponses, values.Results, policyResourcePath, fs, isGit, auditWarn)
 	return resultsMap, testResults, nil
 }
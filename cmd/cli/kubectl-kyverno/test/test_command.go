@@ -7,8 +7,11 @@ import (
 	policyreportv1alpha2 "github.com/kyverno/kyverno/api/policyreport/v1alpha2"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/test/api"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/test/manifest"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/common"
 	sanitizederror "github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/sanitizedError"
+	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/snapshot"
 	"github.com/kyverno/kyverno/cmd/cli/kubectl-kyverno/utils/store"
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
 	"github.com/kyverno/kyverno/pkg/openapi"
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,8 +21,9 @@ import (
 func Command() *cobra.Command {
 	var cmd *cobra.Command
 	var testCase string
-	var fileName, gitBranch string
-	var registryAccess, failOnly, removeColor, manifestValidate, manifestMutate, compact bool
+	var fileName, gitBranch, coverageFile, snapshotPath string
+	var functionFiles []string
+	var registryAccess, failOnly, removeColor, manifestValidate, manifestMutate, compact, withCoverage bool
 	cmd = &cobra.Command{
 		Use: "test <path_to_folder_Containing_test.yamls> [flags]\n  kyverno test <path_to_gitRepository_with_dir> --git-branch <branchName>\n  kyverno test --manifest-mutate > kyverno-test.yaml\n  kyverno test --manifest-validate > kyverno-test.yaml",
 		// Args:    cobra.ExactArgs(1),
@@ -42,7 +46,26 @@ func Command() *cobra.Command {
 				manifest.PrintValidate()
 			} else {
 				store.SetRegistryAccess(registryAccess)
-				_, err = testCommandExecute(dirPath, fileName, gitBranch, testCase, failOnly, false, compact)
+				if err := common.LoadJMESPathFunctions(functionFiles); err != nil {
+					return sanitizederror.NewWithError("failed to load JMESPath functions", err)
+				}
+				var dClient dclient.Interface
+				if snapshotPath != "" {
+					s, err := snapshot.Load(snapshotPath)
+					if err != nil {
+						return sanitizederror.NewWithError("failed to load snapshot", err)
+					}
+					dClient, err = s.Client()
+					if err != nil {
+						return sanitizederror.NewWithError("failed to create client from snapshot", err)
+					}
+					store.AllowApiCall(true)
+				}
+				var cov *coverage
+				if withCoverage || coverageFile != "" {
+					cov = newCoverage()
+				}
+				_, err = testCommandExecute(dirPath, fileName, gitBranch, testCase, failOnly, false, compact, dClient, cov, coverageFile)
 				if err != nil {
 					log.Log.V(3).Info("a directory is required")
 					return err
@@ -60,6 +83,10 @@ func Command() *cobra.Command {
 	cmd.Flags().BoolVarP(&failOnly, "fail-only", "", false, "If set to true, display all the failing test only as output for the test command")
 	cmd.Flags().BoolVarP(&removeColor, "remove-color", "", false, "Remove any color from output")
 	cmd.Flags().BoolVarP(&compact, "compact", "", true, "Does not show detailed results")
+	cmd.Flags().StringVarP(&snapshotPath, "snapshot", "", "", "Path to a cluster snapshot used to resolve namespace labels and context entries")
+	cmd.Flags().BoolVarP(&withCoverage, "coverage", "", false, "If set to true, report which policy rules were not exercised by the tests")
+	cmd.Flags().StringSliceVarP(&functionFiles, "functions", "", nil, "Load user defined functions from JMESPathFunction manifests")
+	cmd.Flags().StringVarP(&coverageFile, "coverage-output", "", "", "If set, write the coverage report as JSON to the given file (implies --coverage)")
 	return cmd
 }
 
@@ -118,6 +145,9 @@ func testCommandExecute(
 	failOnly bool,
 	auditWarn bool,
 	compact bool,
+	dClient dclient.Interface,
+	cov *coverage,
+	coverageFile string,
 ) (rc *resultCounts, err error) {
 	// check input dir
 	if len(dirPath) == 0 {
@@ -145,8 +175,10 @@ func testCommandExecute(
 			p.resourcePath,
 			rc,
 			openApiManager,
+			dClient,
 			filter,
 			auditWarn,
+			cov,
 		); err != nil {
 			return rc, sanitizederror.NewWithError("failed to apply test command", err)
 		} else if t, err := printTestResult(reports, tests, rc, failOnly, compact); err != nil {
@@ -167,6 +199,15 @@ func testCommandExecute(
 		fmt.Printf("\nTest Summary: %d out of %d tests failed\n", rc.Fail, rc.Pass+rc.Skip+rc.Fail)
 	}
 	fmt.Println()
+	if cov != nil {
+		report := cov.report()
+		printCoverage(report)
+		if coverageFile != "" {
+			if err := writeCoverage(report, coverageFile); err != nil {
+				return rc, sanitizederror.NewWithError("failed to write coverage report", err)
+			}
+		}
+	}
 	if rc.Fail > 0 && !failOnly {
 		printFailedTestResult(table, compact)
 		os.Exit(1)
@@ -97,6 +97,7 @@ type ApplyPolicyConfig struct {
 	Client                    dclient.Interface
 	AuditWarn                 bool
 	Subresources              []Subresource
+	Explain                   bool
 }
 
 // HasVariables - check for variables in the policy
@@ -858,6 +859,7 @@ func initializeMockController(objects []runtime.Object) (*generate.GenerateContr
 		nil,
 		store.ContextLoaderFactory(nil),
 		nil,
+		1,
 	))
 	return c, nil
 }
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	yamlutils "github.com/kyverno/kyverno/pkg/utils/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// LoadJMESPathFunctions reads JMESPathFunction manifests from the given files and registers them as custom functions
+func LoadJMESPathFunctions(files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+	var functions []kyvernov2alpha1.JMESPathFunction
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", file, err)
+		}
+		documents, err := yamlutils.SplitDocuments(data)
+		if err != nil {
+			return fmt.Errorf("failed to split documents in file %s: %w", file, err)
+		}
+		for _, document := range documents {
+			var function kyvernov2alpha1.JMESPathFunction
+			if err := yaml.Unmarshal(document, &function); err != nil {
+				return fmt.Errorf("failed to decode JMESPath function in file %s: %w", file, err)
+			}
+			if function.Kind != "JMESPathFunction" {
+				continue
+			}
+			if errs := function.Validate(); len(errs) != 0 {
+				return fmt.Errorf("invalid JMESPath function %s in file %s: %w", function.Name, file, errs.ToAggregate())
+			}
+			functions = append(functions, function)
+		}
+	}
+	jmespath.SetCustomFunctions(functions...)
+	return nil
+}
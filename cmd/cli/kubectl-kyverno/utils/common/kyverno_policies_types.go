@@ -67,6 +67,11 @@ OuterLoop:
 	if policyWithNamespaceSelector {
 		resourceNamespace := c.Resource.GetNamespace()
 		namespaceLabels = c.NamespaceSelectorMap[c.Resource.GetNamespace()]
+		if len(namespaceLabels) < 1 && c.Client != nil {
+			if namespace, err := c.Client.GetResource(context.TODO(), "v1", "Namespace", "", resourceNamespace); err == nil {
+				namespaceLabels = namespace.GetLabels()
+			}
+		}
 		if resourceNamespace != "default" && len(namespaceLabels) < 1 {
 			return engineResponses, sanitizederror.NewWithError(fmt.Sprintf("failed to get namespace labels for resource %s. use --values-file flag to pass the namespace labels", c.Resource.GetName()), nil)
 		}
@@ -118,6 +123,7 @@ OuterLoop:
 		registryclient.NewOrDie(),
 		store.ContextLoaderFactory(nil),
 		nil,
+		1,
 	)
 	policyContext, err := engine.NewPolicyContext(
 		jp,
@@ -142,7 +148,11 @@ OuterLoop:
 		}
 	}
 
-	mutateResponse := eng.Mutate(context.Background(), policyContext)
+	ctx := context.Background()
+	if c.Explain {
+		ctx = engineapi.WithExplain(ctx)
+	}
+	mutateResponse := eng.Mutate(ctx, policyContext)
 	engineResponses = append(engineResponses, mutateResponse)
 
 	err = processMutateEngineResponse(c, &mutateResponse, resPath)
@@ -163,7 +173,7 @@ OuterLoop:
 
 	var validateResponse engineapi.EngineResponse
 	if policyHasValidate {
-		validateResponse = eng.Validate(context.Background(), policyContext)
+		validateResponse = eng.Validate(ctx, policyContext)
 		ProcessValidateEngineResponse(c.Policy, validateResponse, resPath, c.Rc, c.PolicyReport, c.AuditWarn)
 	}
 
@@ -171,7 +181,7 @@ OuterLoop:
 		engineResponses = append(engineResponses, validateResponse)
 	}
 
-	verifyImageResponse, _ := eng.VerifyAndPatchImages(context.TODO(), policyContext)
+	verifyImageResponse, _ := eng.VerifyAndPatchImages(ctx, policyContext)
 	if !verifyImageResponse.IsEmpty() {
 		engineResponses = append(engineResponses, verifyImageResponse)
 		ProcessValidateEngineResponse(c.Policy, verifyImageResponse, resPath, c.Rc, c.PolicyReport, c.AuditWarn)
@@ -185,7 +195,7 @@ OuterLoop:
 	}
 
 	if policyHasGenerate {
-		generateResponse := eng.ApplyBackgroundChecks(context.TODO(), policyContext)
+		generateResponse := eng.ApplyBackgroundChecks(ctx, policyContext)
 		if !generateResponse.IsEmpty() {
 			newRuleResponse, err := handleGeneratePolicy(&generateResponse, *policyContext, c.RuleToCloneSourceResource)
 			if err != nil {
@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultKinds are always included in a snapshot, they are needed to resolve namespace labels and configmap context entries
+var DefaultKinds = []string{"Namespace", "ConfigMap"}
+
+// Snapshot contains the cluster state needed to apply policies without a cluster
+type Snapshot struct {
+	// APIResources contains the API discovery information
+	APIResources []*metav1.APIResourceList `json:"apiResources,omitempty"`
+	// Resources contains the exported resources
+	Resources []unstructured.Unstructured `json:"resources,omitempty"`
+}
+
+// Load reads a snapshot from the given file
+func Load(path string) (*Snapshot, error) {
+	// We accept the risk of including a user provided file here.
+	data, err := os.ReadFile(filepath.Clean(path)) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := yaml.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", path, err)
+	}
+	return &snapshot, nil
+}
+
+// Write writes the snapshot to the given file
+func (s *Snapshot) Write(path string) error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Clean(path), data, 0o600)
+}
+
+// Client returns a client serving the snapshot content
+func (s *Snapshot) Client() (dclient.Interface, error) {
+	return dclient.NewOfflineClient(s.APIResources, s.Resources...)
+}
+
+// Add adds resources to the snapshot, resources already present are ignored
+func (s *Snapshot) Add(resources ...*unstructured.Unstructured) {
+	keys := map[string]struct{}{}
+	for _, resource := range s.Resources {
+		keys[key(&resource)] = struct{}{}
+	}
+	for _, resource := range resources {
+		if _, ok := keys[key(resource)]; ok {
+			continue
+		}
+		keys[key(resource)] = struct{}{}
+		resource = resource.DeepCopy()
+		resource.SetManagedFields(nil)
+		s.Resources = append(s.Resources, *resource)
+	}
+}
+
+// Export creates a snapshot containing the API discovery information and the resources of the given kinds
+func Export(ctx context.Context, client dclient.Interface, namespace string, kinds ...string) (*Snapshot, error) {
+	_, apiResources, err := client.Discovery().DiscoveryInterface().ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	snapshot := &Snapshot{
+		APIResources: apiResources,
+	}
+	for _, kind := range append(DefaultKinds, kinds...) {
+		group, version, kind, subresource := kubeutils.ParseKindSelector(kind)
+		apis, err := client.Discovery().FindResources(group, version, kind, subresource)
+		if err != nil {
+			return nil, err
+		}
+		for api, resource := range apis {
+			if api.SubResource != "" {
+				continue
+			}
+			ns := namespace
+			if !resource.Namespaced {
+				ns = ""
+			}
+			list, err := client.ListResource(ctx, api.GroupVersion.String(), api.Kind, ns, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", api.GroupVersionKind(), err)
+			}
+			resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+			for i := range list.Items {
+				list.Items[i].SetGroupVersionKind(api.GroupVersionKind())
+				resources = append(resources, &list.Items[i])
+			}
+			snapshot.Add(resources...)
+		}
+	}
+	return snapshot, nil
+}
+
+func key(resource *unstructured.Unstructured) string {
+	return resource.GetAPIVersion() + "/" + resource.GetKind() + "/" + resource.GetNamespace() + "/" + resource.GetName()
+}
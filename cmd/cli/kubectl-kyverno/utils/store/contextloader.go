@@ -8,6 +8,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
+	"github.com/kyverno/kyverno/pkg/engine/context/resolvers"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
 	"github.com/kyverno/kyverno/pkg/logging"
 	"github.com/kyverno/kyverno/pkg/registryclient"
@@ -17,7 +18,7 @@ func ContextLoaderFactory(
 	cmResolver engineapi.ConfigmapResolver,
 ) engineapi.ContextLoaderFactory {
 	return func(policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) engineapi.ContextLoader {
-		inner := engineapi.DefaultContextLoaderFactory(cmResolver)
+		inner := engineapi.DefaultContextLoaderFactory(cmResolver, nil)
 		if IsMock() {
 			return &mockContextLoader{
 				logger:     logging.WithName("MockContextLoaderFactory"),
@@ -65,6 +66,14 @@ func (l *mockContextLoader) Load(
 			if err := engineapi.LoadVariable(l.logger, jp, entry, jsonContext); err != nil {
 				return err
 			}
+		} else if entry.ConfigMap != nil && client != nil && IsApiCallAllowed() {
+			cmResolver, err := resolvers.NewClientBasedResolver(client.GetKubeClient())
+			if err != nil {
+				return err
+			}
+			if err := engineapi.LoadConfigMap(ctx, l.logger, entry, jsonContext, cmResolver); err != nil {
+				return err
+			}
 		} else if entry.APICall != nil && IsApiCallAllowed() {
 			if err := engineapi.LoadAPIData(ctx, jp, l.logger, entry, jsonContext, client); err != nil {
 				return err
@@ -19,6 +19,8 @@ type Configuration interface {
 	UsesApiServerClient() bool
 	UsesMetadataClient() bool
 	UsesKyvernoDynamicClient() bool
+	UsesCertificates() bool
+	UsesWebhookServer() bool
 	FlagSets() []*flag.FlagSet
 }
 
@@ -118,6 +120,18 @@ func WithKyvernoDynamicClient() ConfigurationOption {
 	}
 }
 
+func WithCertificates() ConfigurationOption {
+	return func(c *configuration) {
+		c.usesCertificates = true
+	}
+}
+
+func WithWebhookServer() ConfigurationOption {
+	return func(c *configuration) {
+		c.usesWebhookServer = true
+	}
+}
+
 func WithFlagSets(flagsets ...*flag.FlagSet) ConfigurationOption {
 	return func(c *configuration) {
 		c.flagSets = append(c.flagSets, flagsets...)
@@ -139,6 +153,8 @@ type configuration struct {
 	usesApiServerClient      bool
 	usesMetadataClient       bool
 	usesKyvernoDynamicClient bool
+	usesCertificates         bool
+	usesWebhookServer        bool
 	flagSets                 []*flag.FlagSet
 }
 
@@ -198,6 +214,14 @@ func (c *configuration) UsesKyvernoDynamicClient() bool {
 	return c.usesKyvernoDynamicClient
 }
 
+func (c *configuration) UsesCertificates() bool {
+	return c.usesCertificates
+}
+
+func (c *configuration) UsesWebhookServer() bool {
+	return c.usesWebhookServer
+}
+
 func (c *configuration) FlagSets() []*flag.FlagSet {
 	return c.flagSets
 }
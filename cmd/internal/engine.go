@@ -14,6 +14,7 @@ import (
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	"github.com/kyverno/kyverno/pkg/engine/context/resolvers"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	"github.com/kyverno/kyverno/pkg/metrics"
 	"github.com/kyverno/kyverno/pkg/registryclient"
 	"k8s.io/client-go/kubernetes"
 )
@@ -23,13 +24,15 @@ func NewEngine(
 	logger logr.Logger,
 	configuration config.Configuration,
 	metricsConfiguration config.MetricsConfiguration,
+	metricsManager metrics.MetricsConfigManager,
 	jp jmespath.Interface,
 	client dclient.Interface,
 	rclient registryclient.Client,
 	kubeClient kubernetes.Interface,
 	kyvernoClient versioned.Interface,
+	validationWorkers int,
 ) engineapi.Engine {
-	configMapResolver := NewConfigMapResolver(ctx, logger, kubeClient, 15*time.Minute)
+	configMapResolver := NewConfigMapResolver(ctx, logger, metricsManager, kubeClient, 15*time.Minute)
 	exceptionsSelector := NewExceptionSelector(ctx, logger, kyvernoClient, 15*time.Minute)
 	logger = logger.WithName("engine")
 	logger.Info("setup engine...")
@@ -39,8 +42,9 @@ func NewEngine(
 		jp,
 		client,
 		rclient,
-		engineapi.DefaultContextLoaderFactory(configMapResolver),
+		engineapi.DefaultContextLoaderFactory(configMapResolver, metricsManager),
 		exceptionsSelector,
+		validationWorkers,
 	)
 }
 
@@ -72,6 +76,7 @@ func NewExceptionSelector(
 func NewConfigMapResolver(
 	ctx context.Context,
 	logger logr.Logger,
+	metricsManager metrics.MetricsConfigManager,
 	kubeClient kubernetes.Interface,
 	resyncPeriod time.Duration,
 ) engineapi.ConfigmapResolver {
@@ -86,7 +91,7 @@ func NewConfigMapResolver(
 	checkError(logger, err, "failed to create cache informer factory")
 	informerBasedResolver, err := resolvers.NewInformerBasedResolver(factory.Core().V1().ConfigMaps().Lister())
 	checkError(logger, err, "failed to create informer based resolver")
-	configMapResolver, err := engineapi.NewNamespacedResourceResolver(informerBasedResolver, clientBasedResolver)
+	configMapResolver, err := engineapi.NewNamespacedResourceResolver(resolvers.WithCacheMetrics(informerBasedResolver, metricsManager), clientBasedResolver)
 	checkError(logger, err, "failed to create config map resolver")
 	// start informers and wait for cache sync
 	if !StartInformersAndWaitForCacheSync(ctx, logger, factory) {
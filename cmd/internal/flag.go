@@ -1,12 +1,18 @@
 package internal
 
 import (
+	"crypto/x509"
+	"errors"
 	"flag"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kyverno/kyverno/pkg/leaderelection"
 	"github.com/kyverno/kyverno/pkg/logging"
+	"github.com/kyverno/kyverno/pkg/tls"
+	"github.com/kyverno/kyverno/pkg/webhooks"
 )
 
 var (
@@ -43,6 +49,20 @@ var (
 	registryCredentialHelpers string
 	// leader election
 	leaderElectionRetryPeriod time.Duration
+	// certificates
+	caValidityDuration  time.Duration
+	tlsValidityDuration time.Duration
+	certKeyAlgorithm    = tls.RSA
+	// webhook server
+	webhookServerAddress           string
+	webhookServerPort              int
+	webhookServerClientCAFile      string
+	webhookServerReadTimeout       time.Duration
+	webhookServerWriteTimeout      time.Duration
+	webhookServerReadHeaderTimeout time.Duration
+	webhookServerIdleTimeout       time.Duration
+	webhookServerMaxHeaderBytes    int
+	webhookServerDisableHTTP2      bool
 )
 
 func initLoggingFlags() {
@@ -101,6 +121,32 @@ func initLeaderElectionFlags() {
 	flag.DurationVar(&leaderElectionRetryPeriod, "leaderElectionRetryPeriod", leaderelection.DefaultRetryPeriod, "Configure leader election retry period.")
 }
 
+func initCertificatesFlags() {
+	flag.DurationVar(&caValidityDuration, "caValidityDuration", tls.CAValidityDuration, "Configure the validity duration of the CA certificate generated by Kyverno.")
+	flag.DurationVar(&tlsValidityDuration, "tlsValidityDuration", tls.TLSValidityDuration, "Configure the validity duration of the TLS certificate generated by Kyverno.")
+	flag.Func("certKeyAlgorithm", "Configure the key algorithm of the certificates generated by Kyverno (rsa, ecdsa or ed25519), defaults to 'rsa'.", func(value string) error {
+		algorithm, err := tls.ParseKeyAlgorithm(value)
+		if err != nil {
+			return err
+		}
+		certKeyAlgorithm = algorithm
+		return nil
+	})
+}
+
+func initWebhookServerFlags() {
+	defaults := webhooks.DefaultServerOptions()
+	flag.StringVar(&webhookServerAddress, "webhookServerAddress", defaults.Address, "Address the webhook server binds to, listens on all interfaces if empty.")
+	flag.IntVar(&webhookServerPort, "webhookServerPort", defaults.Port, "Port the webhook server listens on.")
+	flag.StringVar(&webhookServerClientCAFile, "webhookServerClientCAFile", "", "Path to a CA bundle used to verify the client certificate presented by the API server. Client certificates are not required if empty.")
+	flag.DurationVar(&webhookServerReadTimeout, "webhookServerReadTimeout", defaults.ReadTimeout, "Maximum duration for reading an entire request, including the body.")
+	flag.DurationVar(&webhookServerWriteTimeout, "webhookServerWriteTimeout", defaults.WriteTimeout, "Maximum duration before timing out writes of the response.")
+	flag.DurationVar(&webhookServerReadHeaderTimeout, "webhookServerReadHeaderTimeout", defaults.ReadHeaderTimeout, "Amount of time allowed to read request headers.")
+	flag.DurationVar(&webhookServerIdleTimeout, "webhookServerIdleTimeout", defaults.IdleTimeout, "Maximum amount of time to wait for the next request when keep-alives are enabled.")
+	flag.IntVar(&webhookServerMaxHeaderBytes, "webhookServerMaxHeaderBytes", defaults.MaxHeaderBytes, "Maximum number of bytes the webhook server will read parsing the request headers.")
+	flag.BoolVar(&webhookServerDisableHTTP2, "webhookServerDisableHTTP2", defaults.DisableHTTP2, "Set this flag to 'true' to restrict the webhook server to HTTP/1.1.")
+}
+
 type options struct {
 	clientRateLimitQPS   float64
 	clientRateLimitBurst int
@@ -172,6 +218,14 @@ func initFlags(config Configuration, opts ...Option) {
 	if config.UsesLeaderElection() {
 		initLeaderElectionFlags()
 	}
+	// certificates
+	if config.UsesCertificates() {
+		initCertificatesFlags()
+	}
+	// webhook server
+	if config.UsesWebhookServer() {
+		initWebhookServerFlags()
+	}
 	for _, flagset := range config.FlagSets() {
 		flagset.VisitAll(func(f *flag.Flag) {
 			flag.CommandLine.Var(f.Value, f.Name, f.Usage)
@@ -196,6 +250,43 @@ func LeaderElectionRetryPeriod() time.Duration {
 	return leaderElectionRetryPeriod
 }
 
+func CAValidityDuration() time.Duration {
+	return caValidityDuration
+}
+
+func TLSValidityDuration() time.Duration {
+	return tlsValidityDuration
+}
+
+func CertKeyAlgorithm() tls.KeyAlgorithm {
+	return certKeyAlgorithm
+}
+
+func WebhookServerOptions() (webhooks.ServerOptions, error) {
+	options := webhooks.ServerOptions{
+		Address:           webhookServerAddress,
+		Port:              webhookServerPort,
+		ReadTimeout:       webhookServerReadTimeout,
+		WriteTimeout:      webhookServerWriteTimeout,
+		ReadHeaderTimeout: webhookServerReadHeaderTimeout,
+		IdleTimeout:       webhookServerIdleTimeout,
+		MaxHeaderBytes:    webhookServerMaxHeaderBytes,
+		DisableHTTP2:      webhookServerDisableHTTP2,
+	}
+	if webhookServerClientCAFile != "" {
+		data, err := os.ReadFile(webhookServerClientCAFile)
+		if err != nil {
+			return options, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return options, errors.New("failed to parse client CA file, no certificate found")
+		}
+		options.ClientCAs = clientCAs
+	}
+	return options, nil
+}
+
 func printFlagSettings(logger logr.Logger) {
 	logger = logger.WithName("flag")
 	flag.VisitAll(func(f *flag.Flag) {
@@ -7,16 +7,18 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/metrics"
 	"github.com/kyverno/kyverno/pkg/registryclient"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 )
 
-func setupRegistryClient(ctx context.Context, logger logr.Logger, client kubernetes.Interface) registryclient.Client {
+func setupRegistryClient(ctx context.Context, logger logr.Logger, client kubernetes.Interface, metricsManager metrics.MetricsConfigManager) registryclient.Client {
 	logger = logger.WithName("registry-client").WithValues("secrets", imagePullSecrets, "insecure", allowInsecureRegistry)
 	logger.Info("setup registry client...")
 	registryOptions := []registryclient.Option{
 		registryclient.WithTracing(),
+		registryclient.WithMetrics(metricsManager),
 	}
 	secrets := strings.Split(imagePullSecrets, ",")
 	if imagePullSecrets != "" && len(secrets) > 0 {
@@ -60,7 +60,7 @@ func Setup(config Configuration, name string, skipResourceFilters bool) (context
 	setupCosign(logger)
 	var registryClient registryclient.Client
 	if config.UsesRegistryClient() {
-		registryClient = setupRegistryClient(ctx, logger, client)
+		registryClient = setupRegistryClient(ctx, logger, client, metricsManager)
 	}
 	var leaderElectionClient kubeclient.UpstreamInterface
 	if config.UsesLeaderElection() {
@@ -16,10 +16,12 @@ import (
 	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
 	kyvernoinformer "github.com/kyverno/kyverno/pkg/client/informers/externalversions"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/controllers/certmanager"
 	genericloggingcontroller "github.com/kyverno/kyverno/pkg/controllers/generic/logging"
 	genericwebhookcontroller "github.com/kyverno/kyverno/pkg/controllers/generic/webhook"
+	jmespathcontroller "github.com/kyverno/kyverno/pkg/controllers/jmespath"
 	policymetricscontroller "github.com/kyverno/kyverno/pkg/controllers/metrics/policy"
 	openapicontroller "github.com/kyverno/kyverno/pkg/controllers/openapi"
 	policycachecontroller "github.com/kyverno/kyverno/pkg/controllers/policycache"
@@ -41,7 +43,6 @@ import (
 	webhooksresource "github.com/kyverno/kyverno/pkg/webhooks/resource"
 	webhookgenerate "github.com/kyverno/kyverno/pkg/webhooks/updaterequest"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
-	corev1 "k8s.io/api/core/v1"
 	apiserver "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -89,11 +90,18 @@ func createNonLeaderControllers(
 		dynamicClient,
 		manager,
 	)
+	jmespathController := jmespathcontroller.NewController(
+		kyvernoInformer.Kyverno().V2alpha1().JMESPathFunctions(),
+	)
 	return []internal.Controller{
 			internal.NewController(policycachecontroller.ControllerName, policyCacheController, policycachecontroller.Workers),
 			internal.NewController(openapicontroller.ControllerName, openApiController, openapicontroller.Workers),
+			internal.NewController(jmespathcontroller.ControllerName, jmespathController, jmespathcontroller.Workers),
 		},
 		func(ctx context.Context) error {
+			if err := jmespathController.WarmUp(); err != nil {
+				return err
+			}
 			if err := policyCacheController.WarmUp(); err != nil {
 				return err
 			}
@@ -182,19 +190,24 @@ func main() {
 		serverIP                     string
 		webhookTimeout               int
 		maxQueuedEvents              int
+		maxQueuedCloudEvents         int
 		omitEvents                   string
+		eventAggregationWindow       time.Duration
 		autoUpdateWebhooks           bool
 		webhookRegistrationTimeout   time.Duration
 		admissionReports             bool
 		dumpPayload                  bool
 		servicePort                  int
 		backgroundServiceAccountName string
+		validationWorkers            int
 	)
 	flagset := flag.NewFlagSet("kyverno", flag.ExitOnError)
 	flagset.BoolVar(&dumpPayload, "dumpPayload", false, "Set this flag to activate/deactivate debug mode.")
 	flagset.IntVar(&webhookTimeout, "webhookTimeout", webhookcontroller.DefaultWebhookTimeout, "Timeout for webhook configurations.")
 	flagset.IntVar(&maxQueuedEvents, "maxQueuedEvents", 1000, "Maximum events to be queued.")
-	flagset.StringVar(&omitEvents, "omit-events", "", "Set this flag to a comma sperated list of PolicyViolation, PolicyApplied, PolicyError, PolicySkipped to disable events, e.g. --omit-events=PolicyApplied,PolicyViolation")
+	flagset.IntVar(&maxQueuedCloudEvents, "maxQueuedCloudEvents", cloudevents.DefaultMaxQueuedEvents, "Maximum CloudEvents to be queued before new events are dropped.")
+	flagset.StringVar(&omitEvents, "omit-events", "", "Set this flag to a comma sperated list of PolicyViolation, PolicyApplied, PolicyError, PolicySkipped, PolicyConflict, ResourceMutated to disable events, e.g. --omit-events=PolicyApplied,PolicyViolation")
+	flagset.DurationVar(&eventAggregationWindow, "eventAggregationWindow", event.DefaultAggregationWindow, "Minimum duration between two identical events, identical events received within the window are aggregated into a single event, events are not aggregated if zero.")
 	flagset.StringVar(&serverIP, "serverIP", "", "IP address where Kyverno controller runs. Only required if out-of-cluster.")
 	flagset.BoolVar(&autoUpdateWebhooks, "autoUpdateWebhooks", true, "Set this flag to 'false' to disable auto-configuration of the webhook.")
 	flagset.DurationVar(&webhookRegistrationTimeout, "webhookRegistrationTimeout", 120*time.Second, "Timeout for webhook registration, e.g., 30s, 1m, 5m.")
@@ -203,6 +216,7 @@ func main() {
 	flagset.BoolVar(&admissionReports, "admissionReports", true, "Enable or disable admission reports.")
 	flagset.IntVar(&servicePort, "servicePort", 443, "Port used by the Kyverno Service resource and for webhook configurations.")
 	flagset.StringVar(&backgroundServiceAccountName, "backgroundServiceAccountName", "", "Background service account name.")
+	flagset.IntVar(&validationWorkers, "validationWorkers", 1, "Maximum number of validate rules and policies evaluated concurrently for a single admission request.")
 	// config
 	appConfig := internal.NewConfiguration(
 		internal.WithProfiling(),
@@ -218,6 +232,8 @@ func main() {
 		internal.WithDynamicClient(),
 		internal.WithKyvernoDynamicClient(),
 		internal.WithApiServerClient(),
+		internal.WithCertificates(),
+		internal.WithWebhookServer(),
 		internal.WithFlagSets(flagset),
 	)
 	// parse flags
@@ -240,6 +256,7 @@ func main() {
 	kubeKyvernoInformer := kubeinformers.NewSharedInformerFactoryWithOptions(setup.KubeClient, resyncPeriod, kubeinformers.WithNamespace(config.KyvernoNamespace()))
 	kyvernoInformer := kyvernoinformer.NewSharedInformerFactory(setup.KyvernoClient, resyncPeriod)
 	secretLister := kubeKyvernoInformer.Core().V1().Secrets().Lister().Secrets(config.KyvernoNamespace())
+	keyPairCache := tls.NewKeyPairCache(kubeKyvernoInformer.Core().V1().Secrets())
 	openApiManager, err := openapi.NewManager(setup.Logger.WithName("openapi"))
 	if err != nil {
 		setup.Logger.Error(err, "Failed to create openapi manager")
@@ -250,8 +267,9 @@ func main() {
 		setup.KubeClient.CoreV1().Secrets(config.KyvernoNamespace()),
 		secretLister,
 		tls.CertRenewalInterval,
-		tls.CAValidityDuration,
-		tls.TLSValidityDuration,
+		internal.CAValidityDuration(),
+		internal.TLSValidityDuration(),
+		internal.CertKeyAlgorithm(),
 		serverIP,
 	)
 	policyCache := policycache.NewCache()
@@ -265,8 +283,15 @@ func main() {
 		kyvernoInformer.Kyverno().V1().Policies(),
 		maxQueuedEvents,
 		omitEventsValues,
+		eventAggregationWindow,
 		logging.WithName("EventGenerator"),
 	)
+	cloudEventsPublisher := cloudevents.NewPublisher(
+		setup.Configuration,
+		setup.KubeClient.CoreV1().Secrets(config.KyvernoNamespace()),
+		maxQueuedCloudEvents,
+		logging.WithName("CloudEventsPublisher"),
+	)
 	// this controller only subscribe to events, nothing is returned...
 	policymetricscontroller.NewController(
 		setup.MetricsManager,
@@ -299,11 +324,13 @@ func main() {
 		setup.Logger,
 		setup.Configuration,
 		setup.MetricsConfiguration,
+		setup.MetricsManager,
 		setup.Jp,
 		setup.KyvernoDynamicClient,
 		setup.RegistryClient,
 		setup.KubeClient,
 		setup.KyvernoClient,
+		validationWorkers,
 	)
 	// create non leader controllers
 	nonLeaderControllers, nonLeaderBootstrap := createNonLeaderControllers(
@@ -331,6 +358,8 @@ func main() {
 	}
 	// start event generator
 	go eventGenerator.Run(signalCtx, 3, &wg)
+	// start cloud events publisher
+	cloudEventsPublisher.Run(signalCtx, &wg)
 	// setup leader election
 	le, err := leaderelection.New(
 		setup.Logger.WithName("leader-election"),
@@ -428,15 +457,22 @@ func main() {
 		kyvernoInformer.Kyverno().V1().Policies(),
 		urgen,
 		eventGenerator,
+		cloudEventsPublisher,
 		openApiManager,
 		admissionReports,
 		backgroundServiceAccountName,
 		setup.Jp,
+		validationWorkers,
 	)
 	exceptionHandlers := webhooksexception.NewHandlers(exception.ValidationOptions{
 		Enabled:   internal.PolicyExceptionEnabled(),
 		Namespace: internal.ExceptionNamespace(),
 	})
+	serverOptions, err := internal.WebhookServerOptions()
+	if err != nil {
+		setup.Logger.Error(err, "failed to configure webhook server")
+		os.Exit(1)
+	}
 	server := webhooks.NewServer(
 		policyHandlers,
 		resourceHandlers,
@@ -446,13 +482,8 @@ func main() {
 		webhooks.DebugModeOptions{
 			DumpPayload: dumpPayload,
 		},
-		func() ([]byte, []byte, error) {
-			secret, err := secretLister.Get(tls.GenerateTLSPairSecretName())
-			if err != nil {
-				return nil, nil, err
-			}
-			return secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], nil
-		},
+		keyPairCache.GetCertificate,
+		serverOptions,
 		setup.KubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations(),
 		setup.KubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations(),
 		setup.KubeClient.CoordinationV1().Leases(config.KyvernoNamespace()),
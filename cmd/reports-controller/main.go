@@ -13,7 +13,9 @@ import (
 	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
 	kyvernoinformer "github.com/kyverno/kyverno/pkg/client/informers/externalversions"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
+	jmespathcontroller "github.com/kyverno/kyverno/pkg/controllers/jmespath"
 	admissionreportcontroller "github.com/kyverno/kyverno/pkg/controllers/report/admission"
 	aggregatereportcontroller "github.com/kyverno/kyverno/pkg/controllers/report/aggregate"
 	backgroundscancontroller "github.com/kyverno/kyverno/pkg/controllers/report/background"
@@ -49,6 +51,7 @@ func createReportControllers(
 	configuration config.Configuration,
 	jp jmespath.Interface,
 	eventGenerator event.Interface,
+	cloudEventsPublisher cloudevents.Interface,
 ) ([]internal.Controller, func(context.Context) error) {
 	var ctrls []internal.Controller
 	var warmups []func(context.Context) error
@@ -106,6 +109,7 @@ func createReportControllers(
 					configuration,
 					jp,
 					eventGenerator,
+					cloudEventsPublisher,
 				),
 				backgroundScanWorkers,
 			))
@@ -136,6 +140,7 @@ func createrLeaderControllers(
 	configuration config.Configuration,
 	jp jmespath.Interface,
 	eventGenerator event.Interface,
+	cloudEventsPublisher cloudevents.Interface,
 	backgroundScanInterval time.Duration,
 ) ([]internal.Controller, func(context.Context) error, error) {
 	reportControllers, warmup := createReportControllers(
@@ -154,6 +159,7 @@ func createrLeaderControllers(
 		configuration,
 		jp,
 		eventGenerator,
+		cloudEventsPublisher,
 	)
 	return reportControllers, warmup, nil
 }
@@ -166,7 +172,9 @@ func main() {
 		backgroundScanWorkers  int
 		backgroundScanInterval time.Duration
 		maxQueuedEvents        int
+		maxQueuedCloudEvents   int
 		omitEvents             string
+		eventAggregationWindow time.Duration
 		skipResourceFilters    bool
 	)
 	flagset := flag.NewFlagSet("reports-controller", flag.ExitOnError)
@@ -176,7 +184,9 @@ func main() {
 	flagset.IntVar(&backgroundScanWorkers, "backgroundScanWorkers", backgroundscancontroller.Workers, "Configure the number of background scan workers.")
 	flagset.DurationVar(&backgroundScanInterval, "backgroundScanInterval", time.Hour, "Configure background scan interval.")
 	flagset.IntVar(&maxQueuedEvents, "maxQueuedEvents", 1000, "Maximum events to be queued.")
+	flagset.IntVar(&maxQueuedCloudEvents, "maxQueuedCloudEvents", cloudevents.DefaultMaxQueuedEvents, "Maximum CloudEvents to be queued before new events are dropped.")
 	flagset.StringVar(&omitEvents, "omit-events", "", "Set this flag to a comma sperated list of PolicyViolation, PolicyApplied, PolicyError, PolicySkipped to disable events, e.g. --omit-events=PolicyApplied,PolicyViolation")
+	flagset.DurationVar(&eventAggregationWindow, "eventAggregationWindow", event.DefaultAggregationWindow, "Minimum duration between two identical events, identical events received within the window are aggregated into a single event, events are not aggregated if zero.")
 	flagset.BoolVar(&skipResourceFilters, "skipResourceFilters", true, "If true, resource filters wont be considered.")
 	// config
 	appConfig := internal.NewConfiguration(
@@ -220,28 +230,49 @@ func main() {
 		kyvernoInformer.Kyverno().V1().Policies(),
 		maxQueuedEvents,
 		omitEventsValues,
+		eventAggregationWindow,
 		logging.WithName("EventGenerator"),
 	)
+	cloudEventsPublisher := cloudevents.NewPublisher(
+		setup.Configuration,
+		setup.KubeClient.CoreV1().Secrets(config.KyvernoNamespace()),
+		maxQueuedCloudEvents,
+		logging.WithName("CloudEventsPublisher"),
+	)
 	// engine
 	engine := internal.NewEngine(
 		ctx,
 		setup.Logger,
 		setup.Configuration,
 		setup.MetricsConfiguration,
+		setup.MetricsManager,
 		setup.Jp,
 		setup.KyvernoDynamicClient,
 		setup.RegistryClient,
 		setup.KubeClient,
 		setup.KyvernoClient,
+		1,
+	)
+	jmespathController := jmespathcontroller.NewController(
+		kyvernoInformer.Kyverno().V2alpha1().JMESPathFunctions(),
 	)
 	// start informers and wait for cache sync
 	if !internal.StartInformersAndWaitForCacheSync(ctx, setup.Logger, kyvernoInformer) {
 		setup.Logger.Error(errors.New("failed to wait for cache sync"), "failed to wait for cache sync")
 		os.Exit(1)
 	}
+	// register user defined jmespath functions
+	if err := jmespathController.WarmUp(); err != nil {
+		setup.Logger.Error(err, "failed to register jmespath functions")
+		os.Exit(1)
+	}
 	// start event generator
 	var wg sync.WaitGroup
 	go eventGenerator.Run(ctx, 3, &wg)
+	// start cloud events publisher
+	cloudEventsPublisher.Run(ctx, &wg)
+	// start non leader controllers
+	internal.NewController(jmespathcontroller.ControllerName, jmespathController, jmespathcontroller.Workers).Run(ctx, setup.Logger.WithName("controllers"), &wg)
 	// setup leader election
 	le, err := leaderelection.New(
 		setup.Logger.WithName("leader-election"),
@@ -273,6 +304,7 @@ func main() {
 				setup.Configuration,
 				setup.Jp,
 				eventGenerator,
+				cloudEventsPublisher,
 				backgroundScanInterval,
 			)
 			if err != nil {
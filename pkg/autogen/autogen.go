@@ -64,17 +64,17 @@ func stripCronJob(controllers string) string {
 //   - name or selector is defined
 //   - mixed kinds (Pod + pod controller) is defined
 //   - Pod and PodControllers are not defined
-//   - mutate.Patches/mutate.PatchesJSON6902/validate.deny/generate rule is defined
+//   - mutate.Patches/mutate.PatchesJSON6902/mutate.PatchJSONMerge/mutate.Expressions/validate.deny/generate rule is defined
 //
 // - otherwise it returns all pod controllers
 func CanAutoGen(spec *kyvernov1.Spec) (applyAutoGen bool, controllers string) {
 	needed := false
 	for _, rule := range spec.Rules {
-		if rule.Mutation.PatchesJSON6902 != "" || rule.HasGenerate() {
+		if rule.Mutation.PatchesJSON6902 != "" || rule.Mutation.RawPatchJSONMerge != nil || len(rule.Mutation.Expressions) > 0 || rule.HasGenerate() {
 			return false, "none"
 		}
 		for _, foreach := range rule.Mutation.ForEachMutation {
-			if foreach.PatchesJSON6902 != "" {
+			if foreach.PatchesJSON6902 != "" || foreach.RawPatchJSONMerge != nil || len(foreach.Expressions) > 0 {
 				return false, "none"
 			}
 		}
@@ -260,6 +260,7 @@ func convertRule(rule kyvernoRule, kind string) (*kyvernov1.Rule, error) {
 	out := kyvernov1.Rule{
 		Name:         rule.Name,
 		VerifyImages: rule.VerifyImages,
+		Timeout:      rule.Timeout,
 	}
 	if rule.MatchResources != nil {
 		out.MatchResources = *rule.MatchResources
@@ -9,6 +9,7 @@ import (
 	datautils "github.com/kyverno/kyverno/pkg/utils/data"
 	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // the kyvernoRule holds the temporary kyverno rule struct
@@ -29,6 +30,7 @@ type kyvernoRule struct {
 	Mutation         *kyvernov1.Mutation           `json:"mutate,omitempty"`
 	Validation       *kyvernov1.Validation         `json:"validate,omitempty"`
 	VerifyImages     []kyvernov1.ImageVerification `json:"verifyImages,omitempty" yaml:"verifyImages,omitempty"`
+	Timeout          *metav1.Duration              `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 func createRule(rule *kyvernov1.Rule) *kyvernoRule {
@@ -38,6 +40,7 @@ func createRule(rule *kyvernov1.Rule) *kyvernoRule {
 	jsonFriendlyStruct := kyvernoRule{
 		Name:         rule.Name,
 		VerifyImages: rule.VerifyImages,
+		Timeout:      rule.Timeout,
 	}
 	if !datautils.DeepEqual(rule.MatchResources, kyvernov1.MatchResources{}) {
 		jsonFriendlyStruct.MatchResources = rule.MatchResources.DeepCopy()
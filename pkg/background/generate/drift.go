@@ -0,0 +1,380 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov1beta1 "github.com/kyverno/kyverno/api/kyverno/v1beta1"
+	"github.com/kyverno/kyverno/pkg/autogen"
+	"github.com/kyverno/kyverno/pkg/background/common"
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	"github.com/kyverno/kyverno/pkg/engine/variables"
+	"github.com/kyverno/kyverno/pkg/utils"
+	datautils "github.com/kyverno/kyverno/pkg/utils/data"
+	engineutils "github.com/kyverno/kyverno/pkg/utils/engine"
+	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
+	"go.uber.org/multierr"
+	yamlv2 "gopkg.in/yaml.v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// Drift describes a downstream resource that no longer matches the state expected by its generate or mutateExisting rule
+type Drift struct {
+	// Type is the type of the rule, Generate or Mutate
+	Type kyvernov1beta1.RequestType
+	// Rule is the name of the rule
+	Rule string
+	// Trigger is the resource that triggered the generation or the mutation
+	Trigger kyvernov1.ResourceSpec
+	// Downstream is the generated resource or the target of the mutation
+	Downstream unstructured.Unstructured
+	// Reason describes the difference with the expected state
+	Reason string
+}
+
+// DriftDetector compares the downstream resources of synchronized generate rules and the targets of mutateExisting rules
+// with their expected state
+type DriftDetector struct {
+	client        dclient.Interface
+	engine        engineapi.Engine
+	nsLister      corev1listers.NamespaceLister
+	configuration config.Configuration
+	jp            jmespath.Interface
+	log           logr.Logger
+}
+
+// NewDriftDetector returns an instance of the drift detector
+func NewDriftDetector(
+	client dclient.Interface,
+	engine engineapi.Engine,
+	nsLister corev1listers.NamespaceLister,
+	configuration config.Configuration,
+	jp jmespath.Interface,
+	log logr.Logger,
+) *DriftDetector {
+	return &DriftDetector{
+		client:        client,
+		engine:        engine,
+		nsLister:      nsLister,
+		configuration: configuration,
+		jp:            jp,
+		log:           log,
+	}
+}
+
+// Detect returns the downstream resources of the synchronized generate rules and the targets of the mutateExisting rules
+// of the policy that drifted from their expected state
+func (d *DriftDetector) Detect(ctx context.Context, policy kyvernov1.PolicyInterface) ([]Drift, error) {
+	var drifts []Drift
+	var errs []error
+	for _, rule := range autogen.ComputeRules(policy) {
+		if rule.IsMutateExisting() {
+			mutateDrifts, err := d.detectMutateExisting(ctx, policy, rule)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			drifts = append(drifts, mutateDrifts...)
+			continue
+		}
+		if _, sync := rule.GetGenerateTypeAndSync(); !sync {
+			continue
+		}
+		downstreams, err := d.findDownstream(policy, rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to find downstream resources of rule %s: %w", rule.Name, err))
+		}
+		for _, downstream := range downstreams {
+			drift, err := d.detect(ctx, policy, rule, downstream)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to check downstream resource %s: %w", common.ResourceSpecFromUnstructured(downstream).String(), err))
+				continue
+			}
+			if drift != nil {
+				drifts = append(drifts, *drift)
+			}
+		}
+	}
+	return drifts, multierr.Combine(errs...)
+}
+
+func (d *DriftDetector) detect(ctx context.Context, policy kyvernov1.PolicyInterface, rule kyvernov1.Rule, downstream unstructured.Unstructured) (*Drift, error) {
+	triggerSpec := TriggerFromLabels(downstream.GetLabels())
+	logger := d.log.WithValues("policy", policy.GetName(), "rule", rule.Name, "trigger", triggerSpec.String(), "downstream", downstream.GetName())
+	trigger, err := d.client.GetResource(ctx, triggerSpec.GetAPIVersion(), triggerSpec.GetKind(), triggerSpec.GetNamespace(), triggerSpec.GetName())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// downstream resources of deleted triggers are handled by the update requests
+			logger.V(4).Info("trigger resource not found, skipping")
+			return nil, nil
+		}
+		return nil, err
+	}
+	ur := &kyvernov1beta1.UpdateRequest{
+		Spec: kyvernov1beta1.UpdateRequestSpec{
+			Type:     kyvernov1beta1.Generate,
+			Policy:   common.PolicyKey(policy.GetNamespace(), policy.GetName()),
+			Rule:     rule.Name,
+			Resource: triggerSpec,
+		},
+	}
+	namespaceLabels := engineutils.GetNamespaceSelectorsFromNamespaceLister(trigger.GetKind(), trigger.GetNamespace(), d.nsLister, logger)
+	policyContext, err := common.NewBackgroundContext(logger, d.client, ur, policy, trigger, d.configuration, d.jp, namespaceLabels)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.engine.ContextLoader(policy, rule)(ctx, rule.Context, policyContext.JSONContext()); err != nil {
+		return nil, err
+	}
+	if rule, err = variables.SubstituteAllInRule(logger, policyContext.JSONContext(), rule); err != nil {
+		return nil, err
+	}
+	var reason string
+	if len(rule.Generation.CloneList.Kinds) != 0 {
+		reason, err = d.compareCloneList(ctx, logger, rule.Generation, downstream)
+	} else if kind, name, namespace, apiVersion, err := getResourceInfoForDataAndClone(rule); err != nil {
+		return nil, err
+	} else if kind != downstream.GetKind() || name != downstream.GetName() || namespace != downstream.GetNamespace() {
+		// the rule now targets a different resource, this is handled on policy updates
+		logger.V(4).Info("downstream resource is not the target of the rule, skipping")
+		return nil, nil
+	} else if rule.Generation.Clone.Name != "" {
+		reason, err = d.compareClone(ctx, logger, apiVersion, kind, rule.Generation.Clone, downstream)
+	} else {
+		reason, err = compareData(logger, rule.Generation.GetData(), downstream)
+	}
+	if err != nil || reason == "" {
+		return nil, err
+	}
+	return &Drift{
+		Type:       kyvernov1beta1.Generate,
+		Rule:       rule.Name,
+		Trigger:    triggerSpec,
+		Downstream: downstream,
+		Reason:     reason,
+	}, nil
+}
+
+// compareData returns the difference between the downstream resource and the data of the rule, empty if they match
+func compareData(logger logr.Logger, data interface{}, downstream unstructured.Unstructured) (string, error) {
+	if data == nil {
+		return "", nil
+	}
+	expected, err := datautils.ToMap(data)
+	if err != nil {
+		return "", err
+	}
+	if _, err := ValidateResourceWithPattern(logger, downstream.Object, expected); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
+
+// compareClone returns the difference between the downstream resource and its source, empty if they match,
+// the metadata and status are not compared
+func (d *DriftDetector) compareClone(ctx context.Context, logger logr.Logger, apiVersion, kind string, clone kyvernov1.CloneFrom, downstream unstructured.Unstructured) (string, error) {
+	source, err := d.client.GetResource(ctx, apiVersion, kind, clone.Namespace, clone.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(4).Info("source resource not found, skipping")
+			return "", nil
+		}
+		return "", err
+	}
+	return compareClone(source.Object, downstream.Object), nil
+}
+
+// compareCloneList returns the difference between the downstream resource and the source resource with the same name
+// selected by the cloneList of the rule, empty if they match or if the downstream resource is not a target of the rule anymore
+func (d *DriftDetector) compareCloneList(ctx context.Context, logger logr.Logger, generation kyvernov1.Generation, downstream unstructured.Unstructured) (string, error) {
+	if downstream.GetNamespace() != generation.Namespace {
+		logger.V(4).Info("downstream resource is not the target of the rule, skipping")
+		return "", nil
+	}
+	for _, gvk := range generation.CloneList.Kinds {
+		apiVersion, kind := kubeutils.GetKindFromGVK(gvk)
+		if kind != downstream.GetKind() || (apiVersion != "" && apiVersion != downstream.GetAPIVersion()) {
+			continue
+		}
+		source, err := d.client.GetResource(ctx, downstream.GetAPIVersion(), kind, generation.CloneList.Namespace, downstream.GetName())
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.V(4).Info("source resource not found, skipping")
+				return "", nil
+			}
+			return "", err
+		}
+		if generation.CloneList.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(generation.CloneList.Selector)
+			if err != nil {
+				return "", err
+			}
+			if !selector.Matches(labels.Set(source.GetLabels())) {
+				logger.V(4).Info("source resource is not selected by the rule anymore, skipping")
+				return "", nil
+			}
+		}
+		return compareClone(source.Object, downstream.Object), nil
+	}
+	logger.V(4).Info("downstream resource is not the target of the rule, skipping")
+	return "", nil
+}
+
+func compareClone(source, downstream map[string]interface{}) string {
+	keys := sets.New[string]()
+	for key := range source {
+		keys.Insert(key)
+	}
+	for key := range downstream {
+		keys.Insert(key)
+	}
+	keys.Delete("metadata", "status")
+	for _, key := range sets.List(keys) {
+		if _, ok := source[key]; !ok {
+			return fmt.Sprintf("%s is not present in the source resource", key)
+		}
+		if !datautils.DeepEqual(source[key], downstream[key]) {
+			return fmt.Sprintf("%s differs from the source resource", key)
+		}
+	}
+	return ""
+}
+
+// findDownstream returns the downstream resources of the rule, the kinds of cloneList rules are listed one by one
+func (d *DriftDetector) findDownstream(policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) ([]unstructured.Unstructured, error) {
+	if len(rule.Generation.CloneList.Kinds) == 0 {
+		downstreams, err := FindDownstream(d.client, policy, rule)
+		if err != nil {
+			return nil, err
+		}
+		return downstreams.Items, nil
+	}
+	var items []unstructured.Unstructured
+	var errs []error
+	for _, gvk := range rule.Generation.CloneList.Kinds {
+		apiVersion, kind := kubeutils.GetKindFromGVK(gvk)
+		rule := *rule.DeepCopy()
+		rule.Generation.APIVersion = apiVersion
+		rule.Generation.Kind = kind
+		downstreams, err := FindDownstream(d.client, policy, rule)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, downstreams.Items...)
+	}
+	return items, multierr.Combine(errs...)
+}
+
+// detectMutateExisting returns the targets of the mutateExisting rule that the rule would change again,
+// targets are only checked if they were mutated by the rule or if the policy mutates existing resources on policy updates
+func (d *DriftDetector) detectMutateExisting(ctx context.Context, policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) ([]Drift, error) {
+	triggers, err := d.findTriggers(ctx, policy, rule)
+	var errs []error
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to find trigger resources of rule %s: %w", rule.Name, err))
+	}
+	var drifts []Drift
+	for _, trigger := range triggers {
+		triggerDrifts, err := d.detectMutateExistingTargets(ctx, policy, rule, trigger)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check targets of trigger resource %s: %w", common.ResourceSpecFromUnstructured(trigger).String(), err))
+			continue
+		}
+		drifts = append(drifts, triggerDrifts...)
+	}
+	return drifts, multierr.Combine(errs...)
+}
+
+func (d *DriftDetector) detectMutateExistingTargets(ctx context.Context, policy kyvernov1.PolicyInterface, rule kyvernov1.Rule, trigger unstructured.Unstructured) ([]Drift, error) {
+	triggerSpec := common.ResourceSpecFromUnstructured(trigger)
+	logger := d.log.WithValues("policy", policy.GetName(), "rule", rule.Name, "trigger", triggerSpec.String())
+	ur := &kyvernov1beta1.UpdateRequest{
+		Spec: kyvernov1beta1.UpdateRequestSpec{
+			Type:     kyvernov1beta1.Mutate,
+			Policy:   common.PolicyKey(policy.GetNamespace(), policy.GetName()),
+			Rule:     rule.Name,
+			Resource: triggerSpec,
+		},
+	}
+	namespaceLabels := engineutils.GetNamespaceSelectorsFromNamespaceLister(trigger.GetKind(), trigger.GetNamespace(), d.nsLister, logger)
+	policyContext, err := common.NewBackgroundContext(logger, d.client, ur, policy, &trigger, d.configuration, d.jp, namespaceLabels)
+	if err != nil {
+		return nil, err
+	}
+	var drifts []Drift
+	response := d.engine.Mutate(ctx, policyContext)
+	for _, ruleResponse := range response.PolicyResponse.Rules {
+		// a mutation without patches is skipped, a passing mutation means the target would be changed
+		if ruleResponse.Name() != rule.Name || ruleResponse.Status() != engineapi.RuleStatusPass {
+			continue
+		}
+		target, _, _ := ruleResponse.PatchedTarget()
+		if target == nil {
+			continue
+		}
+		if !policy.GetSpec().MutateExistingOnPolicyUpdate && !mutatedByRule(policy, rule.Name, *target) {
+			logger.V(4).Info("target resource was not mutated by the rule, skipping", "target", target.GetName())
+			continue
+		}
+		var changes []string
+		for _, patch := range ruleResponse.Patches() {
+			changes = append(changes, patch.Operation+" "+patch.Path)
+		}
+		drifts = append(drifts, Drift{
+			Type:       kyvernov1beta1.Mutate,
+			Rule:       rule.Name,
+			Trigger:    triggerSpec,
+			Downstream: *target,
+			Reason:     fmt.Sprintf("the rule would apply the changes %s", strings.Join(changes, ", ")),
+		})
+	}
+	return drifts, nil
+}
+
+// mutatedByRule returns true if the last patches applied to the target were recorded for the rule
+func mutatedByRule(policy kyvernov1.PolicyInterface, rule string, target unstructured.Unstructured) bool {
+	annotation, ok := target.GetAnnotations()[utils.PolicyAnnotation]
+	if !ok {
+		return false
+	}
+	var patches map[string]string
+	if err := yamlv2.Unmarshal([]byte(annotation), &patches); err != nil {
+		return false
+	}
+	policyName := policy.GetName()
+	if policy.GetNamespace() != "" {
+		policyName = policy.GetNamespace() + "/" + policy.GetName()
+	}
+	_, ok = patches[rule+"."+policyName+".kyverno.io"]
+	return ok
+}
+
+// findTriggers returns the resources matching the kinds of the rule, the engine checks the other match and exclude conditions
+func (d *DriftDetector) findTriggers(ctx context.Context, policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) ([]unstructured.Unstructured, error) {
+	var items []unstructured.Unstructured
+	var errs []error
+	for _, gvk := range sets.List(sets.New(rule.MatchResources.GetKinds()...)) {
+		apiVersion, kind := kubeutils.GetKindFromGVK(gvk)
+		if kind == "*" {
+			continue
+		}
+		triggers, err := d.client.ListResource(ctx, apiVersion, kind, policy.GetNamespace(), nil)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, triggers.Items...)
+	}
+	return items, multierr.Combine(errs...)
+}
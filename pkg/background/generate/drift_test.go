@@ -0,0 +1,256 @@
+package generate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov1beta1 "github.com/kyverno/kyverno/api/kyverno/v1beta1"
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/engine"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	"github.com/kyverno/kyverno/pkg/utils"
+	"gotest.tools/assert"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_compareData(t *testing.T) {
+	downstream := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "config",
+			"namespace": "team",
+			"labels":    map[string]interface{}{"team": "a"},
+		},
+		"data": map[string]interface{}{"a": "b", "c": "d"},
+	}}
+	reason, err := compareData(logr.Discard(), map[string]interface{}{"data": map[string]interface{}{"a": "b"}}, downstream)
+	assert.NilError(t, err)
+	assert.Equal(t, "", reason)
+	reason, err = compareData(logr.Discard(), map[string]interface{}{"data": map[string]interface{}{"a": "x"}}, downstream)
+	assert.NilError(t, err)
+	assert.Equal(t, "value 'b' does not match 'x' at path /data/a/", reason)
+	reason, err = compareData(logr.Discard(), nil, downstream)
+	assert.NilError(t, err)
+	assert.Equal(t, "", reason)
+}
+
+func Test_compareClone(t *testing.T) {
+	source := map[string]interface{}{
+		"kind":     "Secret",
+		"metadata": map[string]interface{}{"name": "regcred", "namespace": "default"},
+		"data":     map[string]interface{}{"token": "YQ=="},
+	}
+	tests := []struct {
+		name       string
+		downstream map[string]interface{}
+		want       string
+	}{{
+		name: "same content",
+		downstream: map[string]interface{}{
+			"kind":     "Secret",
+			"metadata": map[string]interface{}{"name": "regcred", "namespace": "team"},
+			"data":     map[string]interface{}{"token": "YQ=="},
+		},
+	}, {
+		name: "changed data",
+		downstream: map[string]interface{}{
+			"kind":     "Secret",
+			"metadata": map[string]interface{}{"name": "regcred", "namespace": "team"},
+			"data":     map[string]interface{}{"token": "Yg=="},
+		},
+		want: "data differs from the source resource",
+	}, {
+		name: "added field",
+		downstream: map[string]interface{}{
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": "regcred", "namespace": "team"},
+			"data":       map[string]interface{}{"token": "YQ=="},
+			"stringData": map[string]interface{}{"user": "a"},
+		},
+		want: "stringData is not present in the source resource",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, compareClone(source, tt.downstream))
+		})
+	}
+}
+
+func Test_compareCloneList(t *testing.T) {
+	source := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "regcred",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"allowedToBeCloned": "true"},
+		},
+		"data": map[string]interface{}{"token": "YQ=="},
+	}}
+	gvrToListKind := map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "secrets"}: "SecretList",
+	}
+	client, err := dclient.NewFakeClient(runtime.NewScheme(), gvrToListKind, source)
+	assert.NilError(t, err)
+	client.SetDiscovery(dclient.NewFakeDiscoveryClient(nil))
+	detector := NewDriftDetector(client, nil, nil, nil, nil, logr.Discard())
+	generation := kyvernov1.Generation{
+		ResourceSpec: kyvernov1.ResourceSpec{Namespace: "team"},
+		CloneList: kyvernov1.CloneList{
+			Namespace: "default",
+			Kinds:     []string{"v1/Secret"},
+			Selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"allowedToBeCloned": "true"}},
+		},
+	}
+	downstream := func(namespace, name, token string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+			"data":       map[string]interface{}{"token": token},
+		}}
+	}
+	tests := []struct {
+		name       string
+		downstream unstructured.Unstructured
+		want       string
+	}{{
+		name:       "same data",
+		downstream: downstream("team", "regcred", "YQ=="),
+		want:       "",
+	}, {
+		name:       "different data",
+		downstream: downstream("team", "regcred", "Yg=="),
+		want:       "data differs from the source resource",
+	}, {
+		name:       "other namespace",
+		downstream: downstream("other", "regcred", "Yg=="),
+		want:       "",
+	}, {
+		name:       "source not found",
+		downstream: downstream("team", "other", "Yg=="),
+		want:       "",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := detector.compareCloneList(context.TODO(), logr.Discard(), generation, tt.downstream)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, reason)
+		})
+	}
+}
+
+// configMapDiscovery resolves the ConfigMap targets of mutateExisting rules
+type configMapDiscovery struct {
+	dclient.IDiscovery
+}
+
+func (configMapDiscovery) FindResources(group, version, kind, subresource string) (map[dclient.TopLevelApiDescription]metav1.APIResource, error) {
+	description := dclient.TopLevelApiDescription{
+		GroupVersion: schema.GroupVersion{Version: "v1"},
+		Kind:         "ConfigMap",
+		Resource:     "configmaps",
+	}
+	return map[dclient.TopLevelApiDescription]metav1.APIResource{
+		description: {Name: "configmaps", Version: "v1", Kind: "ConfigMap", Namespaced: true},
+	}, nil
+}
+
+func Test_detectMutateExisting(t *testing.T) {
+	trigger := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": "team"},
+	}}
+	target := func(team string, annotations map[string]interface{}) *unstructured.Unstructured {
+		metadata := map[string]interface{}{
+			"name":      "settings",
+			"namespace": "team",
+			"labels":    map[string]interface{}{"team": team},
+		}
+		if annotations != nil {
+			metadata["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   metadata,
+		}}
+	}
+	mutated := map[string]interface{}{utils.PolicyAnnotation: "label-settings.label-team.kyverno.io: replaced /metadata/labels/team\n"}
+	tests := []struct {
+		name                         string
+		target                       *unstructured.Unstructured
+		mutateExistingOnPolicyUpdate bool
+		want                         string
+	}{{
+		name:   "expected state",
+		target: target("platform", mutated),
+	}, {
+		name:   "drifted target",
+		target: target("other", mutated),
+		want:   "the rule would apply the changes replace /metadata/labels/team",
+	}, {
+		name:   "target not mutated by the rule",
+		target: target("other", nil),
+	}, {
+		name:                         "target not mutated by the rule with mutateExistingOnPolicyUpdate",
+		target:                       target("other", nil),
+		mutateExistingOnPolicyUpdate: true,
+		want:                         "the rule would apply the changes replace /metadata/labels/team",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gvrToListKind := map[schema.GroupVersionResource]string{
+				{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+				{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+			}
+			client, err := dclient.NewFakeClient(runtime.NewScheme(), gvrToListKind, trigger, tt.target)
+			assert.NilError(t, err)
+			client.SetDiscovery(configMapDiscovery{dclient.NewFakeDiscoveryClient(nil)})
+			cfg := config.NewDefaultConfiguration(false)
+			jp := jmespath.New(cfg)
+			eng := engine.NewEngine(cfg, config.NewDefaultMetricsConfiguration(), jp, client, nil, engineapi.DefaultContextLoaderFactory(nil, nil), nil, 1)
+			policy := &kyvernov1.ClusterPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "label-team"},
+				Spec: kyvernov1.Spec{
+					MutateExistingOnPolicyUpdate: tt.mutateExistingOnPolicyUpdate,
+					Rules: []kyvernov1.Rule{{
+						Name: "label-settings",
+						MatchResources: kyvernov1.MatchResources{
+							Any: kyvernov1.ResourceFilters{{ResourceDescription: kyvernov1.ResourceDescription{Kinds: []string{"Namespace"}}}},
+						},
+						Mutation: kyvernov1.Mutation{
+							Targets: []kyvernov1.TargetResourceSpec{{
+								ResourceSpec: kyvernov1.ResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Name: "settings", Namespace: "{{ request.object.metadata.name }}"},
+							}},
+							RawPatchStrategicMerge: &apiextv1.JSON{Raw: []byte(`{"metadata":{"labels":{"team":"platform"}}}`)},
+						},
+					}},
+				},
+			}
+			detector := NewDriftDetector(client, eng, nil, cfg, jp, logr.Discard())
+			drifts, err := detector.Detect(context.TODO(), policy)
+			assert.NilError(t, err)
+			if tt.want == "" {
+				assert.Equal(t, len(drifts), 0)
+				return
+			}
+			assert.Equal(t, len(drifts), 1)
+			assert.Equal(t, drifts[0].Type, kyvernov1beta1.Mutate)
+			assert.Equal(t, drifts[0].Rule, "label-settings")
+			assert.Equal(t, drifts[0].Trigger.String(), "v1/Namespace//team")
+			assert.Equal(t, drifts[0].Downstream.GetName(), "settings")
+			assert.Equal(t, drifts[0].Reason, tt.want)
+		})
+	}
+}
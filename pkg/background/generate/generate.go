@@ -16,6 +16,7 @@ import (
 	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
 	kyvernov1beta1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1beta1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
@@ -54,6 +55,7 @@ type GenerateController struct {
 
 	configuration config.Configuration
 	eventGen      event.Interface
+	publisher     cloudevents.Interface
 
 	log logr.Logger
 	jp  jmespath.Interface
@@ -71,6 +73,7 @@ func NewGenerateController(
 	nsLister corev1listers.NamespaceLister,
 	dynamicConfig config.Configuration,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	log logr.Logger,
 	jp jmespath.Interface,
 ) *GenerateController {
@@ -85,6 +88,7 @@ func NewGenerateController(
 		nsLister:      nsLister,
 		configuration: dynamicConfig,
 		eventGen:      eventGen,
+		publisher:     publisher,
 		log:           log,
 		jp:            jp,
 	}
@@ -250,6 +254,10 @@ func (c *GenerateController) applyGenerate(resource unstructured.Unstructured, u
 		} else {
 			applicableRules = append(applicableRules, r.Name())
 		}
+		// failed rules only indicate that the update request must be removed, passing rules are published once applied
+		if r.HasStatus(engineapi.RuleStatusError, engineapi.RuleStatusSkip) {
+			c.publish(policyContext, r)
+		}
 	}
 
 	// Apply the generate rule on resource
@@ -332,9 +340,15 @@ func (c *GenerateController) ApplyGeneratePolicy(log logr.Logger, policyContext
 		if err != nil {
 			log.Error(err, "failed to apply generate rule", "policy", policy.GetName(),
 				"rule", rule.Name, "resource", resource.GetName(), "suggestion", "users need to grant Kyverno's service account additional privileges")
+			c.publish(policyContext, *engineapi.RuleError(rule.Name, engineapi.Generation, "failed to apply generate rule", err))
 			return nil, err
 		}
+		c.publish(policyContext, *engineapi.RulePass(rule.Name, engineapi.Generation, fmt.Sprintf("generated %d resources", len(genResource))))
 		ruleNameToProcessingTime[rule.Name] = time.Since(startTime)
+		// the CLI doesn't generate events
+		if c.eventGen != nil {
+			c.eventGen.Add(event.NewResourceGeneratedEvents(event.GeneratePolicyController, policy, rule.Name, resource, genResource...)...)
+		}
 		genResources = append(genResources, genResource...)
 		applyCount++
 	}
@@ -342,6 +356,18 @@ func (c *GenerateController) ApplyGeneratePolicy(log logr.Logger, policyContext
 	return genResources, nil
 }
 
+// publish publishes the result of a generate rule for the trigger resource as a CloudEvent
+func (c *GenerateController) publish(policyContext *engine.PolicyContext, ruleResponse engineapi.RuleResponse) {
+	// the CLI doesn't publish events
+	if c.publisher == nil {
+		return
+	}
+	var policyResponse engineapi.PolicyResponse
+	policyResponse.Add(engineapi.ExecutionStats{}, ruleResponse)
+	response := engineapi.NewEngineResponseFromPolicyContext(policyContext).WithPolicyResponse(policyResponse)
+	c.publisher.Publish(event.GeneratePolicyController, false, response)
+}
+
 func getResourceInfo(object map[string]interface{}) (kind, name, namespace, apiversion string, err error) {
 	if kind, _, err = unstructured.NestedString(object, "kind"); err != nil {
 		return "", "", "", "", err
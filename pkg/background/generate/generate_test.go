@@ -0,0 +1,60 @@
+package generate
+
+import (
+	"errors"
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	"github.com/kyverno/kyverno/pkg/engine/policycontext"
+	"github.com/kyverno/kyverno/pkg/event"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type fakePublisher struct {
+	sources   []event.Source
+	responses []engineapi.EngineResponse
+}
+
+func (p *fakePublisher) Publish(source event.Source, _ bool, responses ...engineapi.EngineResponse) {
+	for range responses {
+		p.sources = append(p.sources, source)
+	}
+	p.responses = append(p.responses, responses...)
+}
+
+func Test_publish(t *testing.T) {
+	trigger := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": "team"},
+	}}
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "generate-quota"}}
+	cfg := config.NewDefaultConfiguration(false)
+	policyContext, err := policycontext.NewPolicyContext(jmespath.New(cfg), trigger, kyvernov1.Create, nil, cfg)
+	assert.NilError(t, err)
+	policyContext = policyContext.WithPolicy(policy)
+
+	// the CLI controller has no publisher
+	var c GenerateController
+	c.publish(policyContext, *engineapi.RulePass("quota", engineapi.Generation, "generated 1 resources"))
+
+	publisher := &fakePublisher{}
+	c.publisher = publisher
+	c.publish(policyContext, *engineapi.RulePass("quota", engineapi.Generation, "generated 1 resources"))
+	c.publish(policyContext, *engineapi.RuleError("quota", engineapi.Generation, "failed to apply generate rule", errors.New("denied")))
+
+	assert.Equal(t, len(publisher.responses), 2)
+	for i, response := range publisher.responses {
+		assert.Equal(t, publisher.sources[i], event.GeneratePolicyController)
+		assert.Equal(t, response.Policy().GetName(), "generate-quota")
+		assert.Equal(t, response.Resource.GetName(), "team")
+		assert.Equal(t, len(response.PolicyResponse.Rules), 1)
+	}
+	assert.Equal(t, publisher.responses[0].PolicyResponse.Rules[0].Status(), engineapi.RuleStatusPass)
+	assert.Equal(t, publisher.responses[1].PolicyResponse.Rules[0].Status(), engineapi.RuleStatusError)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/background/common"
 	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
@@ -41,6 +42,7 @@ type mutateExistingController struct {
 
 	configuration config.Configuration
 	eventGen      event.Interface
+	publisher     cloudevents.Interface
 
 	log logr.Logger
 	jp  jmespath.Interface
@@ -56,6 +58,7 @@ func NewMutateExistingController(
 	nsLister corev1listers.NamespaceLister,
 	dynamicConfig config.Configuration,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	log logr.Logger,
 	jp jmespath.Interface,
 ) *mutateExistingController {
@@ -68,6 +71,7 @@ func NewMutateExistingController(
 		nsLister:      nsLister,
 		configuration: dynamicConfig,
 		eventGen:      eventGen,
+		publisher:     publisher,
 		log:           log,
 		jp:            jp,
 	}
@@ -159,10 +163,12 @@ func (c *mutateExistingController) ProcessUR(ur *kyvernov1beta1.UpdateRequest) e
 				logger.Error(err, "")
 				errs = append(errs, err)
 				c.report(err, ur.Spec.Policy, rule.Name, patched)
+				c.publish(policy, r, patched)
 
 			case engineapi.RuleStatusSkip:
 				logger.Info("mutate existing rule skipped", "rule", r.Name(), "message", r.Message())
 				c.report(err, ur.Spec.Policy, rule.Name, patched)
+				c.publish(policy, r, patched)
 
 			case engineapi.RuleStatusPass:
 
@@ -204,6 +210,11 @@ func (c *mutateExistingController) ProcessUR(ur *kyvernov1beta1.UpdateRequest) e
 					}
 
 					c.report(updateErr, ur.Spec.Policy, rule.Name, patched)
+					if updateErr != nil {
+						c.publish(policy, *engineapi.RuleError(r.Name(), r.RuleType(), "failed to update target resource", updateErr), patched)
+					} else {
+						c.publish(policy, r, patched)
+					}
 				}
 			}
 		}
@@ -242,6 +253,17 @@ func (c *mutateExistingController) report(err error, policy, rule string, target
 	c.eventGen.Add(events...)
 }
 
+// publish publishes the result of a mutateExisting rule for the target resource as a CloudEvent
+func (c *mutateExistingController) publish(policy kyvernov1.PolicyInterface, ruleResponse engineapi.RuleResponse, target *unstructured.Unstructured) {
+	if c.publisher == nil || target == nil {
+		return
+	}
+	var policyResponse engineapi.PolicyResponse
+	policyResponse.Add(engineapi.ExecutionStats{}, ruleResponse)
+	response := engineapi.NewEngineResponse(*target, policy, nil).WithPolicyResponse(policyResponse)
+	c.publisher.Publish(event.MutateExistingController, false, response)
+}
+
 func updateURStatus(statusControl common.StatusControlInterface, ur kyvernov1beta1.UpdateRequest, err error) error {
 	if err != nil {
 		if _, err := statusControl.Failed(ur.GetName(), err.Error(), nil); err != nil {
@@ -30,6 +30,7 @@ type KyvernoV2alpha1Interface interface {
 	RESTClient() rest.Interface
 	CleanupPoliciesGetter
 	ClusterCleanupPoliciesGetter
+	JMESPathFunctionsGetter
 	PolicyExceptionsGetter
 }
 
@@ -46,6 +47,10 @@ func (c *KyvernoV2alpha1Client) ClusterCleanupPolicies() ClusterCleanupPolicyInt
 	return newClusterCleanupPolicies(c)
 }
 
+func (c *KyvernoV2alpha1Client) JMESPathFunctions() JMESPathFunctionInterface {
+	return newJMESPathFunctions(c)
+}
+
 func (c *KyvernoV2alpha1Client) PolicyExceptions(namespace string) PolicyExceptionInterface {
 	return newPolicyExceptions(c, namespace)
 }
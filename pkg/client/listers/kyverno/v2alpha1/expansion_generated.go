@@ -30,6 +30,10 @@ type CleanupPolicyNamespaceListerExpansion interface{}
 // ClusterCleanupPolicyLister.
 type ClusterCleanupPolicyListerExpansion interface{}
 
+// JMESPathFunctionListerExpansion allows custom methods to be added to
+// JMESPathFunctionLister.
+type JMESPathFunctionListerExpansion interface{}
+
 // PolicyExceptionListerExpansion allows custom methods to be added to
 // PolicyExceptionLister.
 type PolicyExceptionListerExpansion interface{}
@@ -0,0 +1,134 @@
+package dclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// offlineClient is a client backed by in-memory objects and discovery data
+type offlineClient struct {
+	*client
+}
+
+// NewOfflineClient creates a client that serves the given objects and api resources without talking to an api server.
+// Namespaces and configmaps are also made available through the typed kube client.
+func NewOfflineClient(apiResources []*metav1.APIResourceList, objects ...unstructured.Unstructured) (Interface, error) {
+	disco := serverResources{
+		cachedClient: memory.NewMemCacheClient(&fakediscovery.FakeDiscovery{
+			Fake: &clienttesting.Fake{Resources: apiResources},
+		}),
+	}
+	gvrToListKind := map[schema.GroupVersionResource]string{}
+	for _, list := range apiResources {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range list.APIResources {
+			if !strings.Contains(resource.Name, "/") {
+				gvrToListKind[gv.WithResource(resource.Name)] = resource.Kind + "List"
+			}
+		}
+	}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind)
+	var typed []runtime.Object
+	for i := range objects {
+		obj := objects[i].DeepCopy()
+		gvr, err := disco.GetGVRFromGVK(obj.GroupVersionKind())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find resource for %s: %w", obj.GroupVersionKind(), err)
+		}
+		if err := dyn.Tracker().Create(gvr, obj, obj.GetNamespace()); err != nil {
+			return nil, err
+		}
+		switch obj.GroupVersionKind() {
+		case corev1.SchemeGroupVersion.WithKind("Namespace"):
+			var namespace corev1.Namespace
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &namespace); err != nil {
+				return nil, err
+			}
+			typed = append(typed, &namespace)
+		case corev1.SchemeGroupVersion.WithKind("ConfigMap"):
+			var configMap corev1.ConfigMap
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &configMap); err != nil {
+				return nil, err
+			}
+			typed = append(typed, &configMap)
+		}
+	}
+	return &offlineClient{
+		client: &client{
+			dyn:   dyn,
+			disco: disco,
+			kube:  kubefake.NewSimpleClientset(typed...),
+		},
+	}, nil
+}
+
+// RawAbsPath serves GET requests for resources and resource lists from the in-memory objects
+func (c *offlineClient) RawAbsPath(ctx context.Context, path string, method string, _ io.Reader) ([]byte, error) {
+	if method != "GET" {
+		return nil, fmt.Errorf("method not supported: %s", method)
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	gvr, namespace, name, err := parseResourcePath(u.Path)
+	if err != nil {
+		return nil, err
+	}
+	var resource interface{ MarshalJSON() ([]byte, error) }
+	if name != "" {
+		resource, err = c.dyn.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		resource, err = c.dyn.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: u.Query().Get("labelSelector")})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resource.MarshalJSON()
+}
+
+// parseResourcePath extracts the resource, namespace and name from a kubernetes api path,
+// like /api/v1/namespaces/default/configmaps/foo or /apis/apps/v1/deployments
+func parseResourcePath(path string) (schema.GroupVersionResource, string, string, error) {
+	var gvr schema.GroupVersionResource
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		gvr.Version, parts = parts[1], parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		gvr.Group, gvr.Version, parts = parts[1], parts[2], parts[3:]
+	default:
+		return gvr, "", "", fmt.Errorf("unsupported path: %s", path)
+	}
+	var namespace string
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		namespace, parts = parts[1], parts[2:]
+	}
+	switch len(parts) {
+	case 1:
+		gvr.Resource = parts[0]
+		return gvr, namespace, "", nil
+	case 2:
+		gvr.Resource = parts[0]
+		return gvr, namespace, parts[1], nil
+	default:
+		return gvr, "", "", fmt.Errorf("unsupported path: %s", path)
+	}
+}
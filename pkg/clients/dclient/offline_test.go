@@ -0,0 +1,96 @@
+package dclient
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_parseResourcePath(t *testing.T) {
+	tests := []struct {
+		path      string
+		gvr       schema.GroupVersionResource
+		namespace string
+		name      string
+		wantErr   bool
+	}{{
+		path: "/api/v1/namespaces",
+		gvr:  schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+	}, {
+		path: "/api/v1/namespaces/default",
+		gvr:  schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+		name: "default",
+	}, {
+		path:      "/api/v1/namespaces/default/configmaps/foo",
+		gvr:       schema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+		namespace: "default",
+		name:      "foo",
+	}, {
+		path: "/apis/apps/v1/deployments",
+		gvr:  schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+	}, {
+		path:    "/api/v1/namespaces/default/pods/foo/log",
+		wantErr: true,
+	}, {
+		path:    "/version",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			gvr, namespace, name, err := parseResourcePath(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.gvr, gvr)
+			assert.Equal(t, tt.namespace, namespace)
+			assert.Equal(t, tt.name, name)
+		})
+	}
+}
+
+func TestNewOfflineClient(t *testing.T) {
+	apiResources := []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace"},
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+		},
+	}}
+	namespace := unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName("test")
+	namespace.SetLabels(map[string]string{"team": "a"})
+	configMap := unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName("foo")
+	configMap.SetNamespace("test")
+	client, err := NewOfflineClient(apiResources, namespace, configMap)
+	assert.NoError(t, err)
+
+	ns, err := client.GetResource(context.TODO(), "v1", "Namespace", "", "test")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, ns.GetLabels())
+
+	cm, err := client.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.TODO(), "foo", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", cm.Name)
+
+	data, err := client.RawAbsPath(context.TODO(), "/api/v1/namespaces/test/configmaps", "GET", nil)
+	assert.NoError(t, err)
+	var list unstructured.UnstructuredList
+	assert.NoError(t, json.Unmarshal(data, &list.Object))
+	items, _, _ := unstructured.NestedSlice(list.Object, "items")
+	assert.Len(t, items, 1)
+
+	_, err = client.RawAbsPath(context.TODO(), "/api/v1/namespaces/test/configmaps", "POST", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package cloudevents
+
+import (
+	"strings"
+	"time"
+
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/event"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification the events conform to
+	SpecVersion = "1.0"
+	// PolicyResultType is the type of the events carrying a policy rule result
+	PolicyResultType = "io.kyverno.policy.result.v1"
+	// structuredContentType is the content type of a single event in structured mode
+	structuredContentType = "application/cloudevents+json"
+	// batchContentType is the content type of a list of events in batched mode
+	batchContentType = "application/cloudevents-batch+json"
+	// dataContentType is the content type of the event data
+	dataContentType = "application/json"
+)
+
+// Event is a CloudEvent in JSON format
+type Event struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject,omitempty"`
+	Time            time.Time    `json:"time"`
+	DataContentType string       `json:"datacontenttype,omitempty"`
+	Data            PolicyResult `json:"data"`
+}
+
+// PolicyResult is the data of a policy result event
+type PolicyResult struct {
+	Policy          string         `json:"policy"`
+	PolicyNamespace string         `json:"policyNamespace,omitempty"`
+	Rule            string         `json:"rule"`
+	RuleType        string         `json:"ruleType,omitempty"`
+	Status          string         `json:"status"`
+	Message         string         `json:"message,omitempty"`
+	Blocked         bool           `json:"blocked"`
+	Exception       string         `json:"exception,omitempty"`
+	Resource        ResourceResult `json:"resource"`
+}
+
+// ResourceResult identifies the resource a policy result applies to
+type ResourceResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	UID        string `json:"uid,omitempty"`
+}
+
+// NewPolicyResultEvents creates an event per rule result in the engine responses,
+// blocked indicates whether failing rules caused the admission request to be denied
+func NewPolicyResultEvents(source event.Source, blocked bool, responses ...engineapi.EngineResponse) []Event {
+	var events []Event
+	now := time.Now().UTC()
+	for _, response := range responses {
+		if response.IsEmpty() {
+			continue
+		}
+		policy := response.Policy()
+		spec := response.GetResourceSpec()
+		resourceResult := ResourceResult{
+			APIVersion: spec.APIVersion,
+			Kind:       spec.Kind,
+			Namespace:  spec.Namespace,
+			Name:       spec.Name,
+			UID:        spec.UID,
+		}
+		for _, rule := range response.PolicyResponse.Rules {
+			rule := rule
+			result := PolicyResult{
+				Policy:          policy.GetName(),
+				PolicyNamespace: policy.GetNamespace(),
+				Rule:            rule.Name(),
+				RuleType:        string(rule.RuleType()),
+				Status:          string(rule.Status()),
+				Message:         rule.Message(),
+				Blocked:         blocked && rule.HasStatus(engineapi.RuleStatusFail, engineapi.RuleStatusError),
+				Resource:        resourceResult,
+			}
+			if exception := rule.Exception(); exception != nil {
+				result.Exception = exception.GetNamespace() + "/" + exception.GetName()
+			}
+			events = append(events, Event{
+				SpecVersion:     SpecVersion,
+				ID:              string(uuid.NewUUID()),
+				Source:          string(source),
+				Type:            PolicyResultType,
+				Subject:         subject(resourceResult),
+				Time:            now,
+				DataContentType: dataContentType,
+				Data:            result,
+			})
+		}
+	}
+	return events
+}
+
+// subject returns the resource key in the kind/namespace/name form, the namespace is omitted for cluster wide resources
+func subject(resource ResourceResult) string {
+	parts := []string{resource.Kind}
+	if resource.Namespace != "" {
+		parts = append(parts, resource.Namespace)
+	}
+	return strings.Join(append(parts, resource.Name), "/")
+}
@@ -0,0 +1,15 @@
+package cloudevents
+
+import (
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/event"
+)
+
+func NewFake() Interface {
+	return &fakePublisher{}
+}
+
+type fakePublisher struct{}
+
+func (f *fakePublisher) Publish(source event.Source, blocked bool, responses ...engineapi.EngineResponse) {
+}
@@ -0,0 +1,55 @@
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/kyverno/kyverno/pkg/config"
+)
+
+// httpTransport posts events to an http sink, events are sent in structured mode
+// if the batch size is 1, otherwise in batched mode
+type httpTransport struct {
+	client *http.Client
+	sink   string
+}
+
+func (t *httpTransport) send(ctx context.Context, cfg config.CloudEventsConfig, headers map[string]string, events []Event) (bool, error) {
+	var payload interface{} = events
+	contentType := batchContentType
+	if cfg.BatchSize == 1 && len(events) == 1 {
+		payload, contentType = events[0], structuredContentType
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return false, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, t.sink, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("Content-Type", contentType)
+	response, err := t.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+	// drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("sink responded with status %s", response.Status)
+	return response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests, err
+}
+
+func (t *httpTransport) close() {
+	t.client.CloseIdleConnections()
+}
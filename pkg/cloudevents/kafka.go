@@ -0,0 +1,64 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/segmentio/kafka-go"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// kafkaTransport writes every event in structured mode as a message to a kafka topic,
+// messages are keyed by subject to preserve the order of the events of a resource
+type kafkaTransport struct {
+	writer *kafka.Writer
+}
+
+func newKafkaTransport(broker, topic string) *kafkaTransport {
+	return &kafkaTransport{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(broker),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// events are already batched and retried by the publisher
+			MaxAttempts:  1,
+			BatchTimeout: 10 * time.Millisecond,
+			WriteTimeout: requestTimeout,
+		},
+	}
+}
+
+func (t *kafkaTransport) send(ctx context.Context, _ config.CloudEventsConfig, headers map[string]string, events []Event) (bool, error) {
+	messages, err := kafkaMessages(headers, events)
+	if err != nil {
+		return false, err
+	}
+	return true, t.writer.WriteMessages(ctx, messages...)
+}
+
+func (t *kafkaTransport) close() {
+	_ = t.writer.Close()
+}
+
+func kafkaMessages(headers map[string]string, events []Event) ([]kafka.Message, error) {
+	messages := make([]kafka.Message, 0, len(events))
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		message := kafka.Message{
+			Key:   []byte(e.Subject),
+			Value: data,
+		}
+		for _, key := range sets.List(sets.KeySet(headers)) {
+			message.Headers = append(message.Headers, kafka.Header{Key: key, Value: []byte(headers[key])})
+		}
+		message.Headers = append(message.Headers, kafka.Header{Key: contentTypeHeader, Value: []byte(structuredContentType)})
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
@@ -0,0 +1,61 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/nats-io/nats.go"
+)
+
+// natsTransport publishes every event in structured mode as a message on a nats subject
+type natsTransport struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func newNatsTransport(server, subject string) (*natsTransport, error) {
+	conn, err := nats.Connect(server, nats.Name("kyverno"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	return &natsTransport{conn: conn, subject: subject}, nil
+}
+
+func (t *natsTransport) send(ctx context.Context, _ config.CloudEventsConfig, headers map[string]string, events []Event) (bool, error) {
+	messages, err := natsMessages(t.subject, headers, events)
+	if err != nil {
+		return false, err
+	}
+	for _, message := range messages {
+		if err := t.conn.PublishMsg(message); err != nil {
+			return true, err
+		}
+	}
+	// flushing waits for the server to process the messages, it requires a deadline
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	return true, t.conn.FlushWithContext(ctx)
+}
+
+func (t *natsTransport) close() {
+	t.conn.Close()
+}
+
+func natsMessages(subject string, headers map[string]string, events []Event) ([]*nats.Msg, error) {
+	messages := make([]*nats.Msg, 0, len(events))
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		message := nats.NewMsg(subject)
+		message.Data = data
+		for key, value := range headers {
+			message.Header.Set(key, value)
+		}
+		message.Header.Set(contentTypeHeader, structuredContentType)
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
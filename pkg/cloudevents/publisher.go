@@ -0,0 +1,236 @@
+package cloudevents
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/event"
+	"github.com/kyverno/kyverno/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// DefaultMaxQueuedEvents is the default number of events buffered before new events are dropped
+	DefaultMaxQueuedEvents = 10000
+	// shutdownTimeout is the time allowed to send the pending events when the publisher stops
+	shutdownTimeout = 5 * time.Second
+)
+
+// Interface publishes policy results as CloudEvents
+type Interface interface {
+	// Publish converts the engine responses to events and queues them for delivery, it never blocks
+	Publish(source event.Source, blocked bool, responses ...engineapi.EngineResponse)
+}
+
+// Controller publishes the queued events to the sink configured in the Kyverno ConfigMap
+type Controller interface {
+	Interface
+	Run(ctx context.Context, waitGroup *sync.WaitGroup)
+}
+
+type publisher struct {
+	configuration config.Configuration
+	secrets       corev1client.SecretInterface
+	queue         chan Event
+	log           logr.Logger
+	eventsMetric  metric.Int64Counter
+	// transport delivers events to the current sink, both are only used by the run goroutine
+	transport transport
+	sink      string
+}
+
+// NewPublisher creates a publisher buffering up to maxQueuedEvents events,
+// new events are dropped when the buffer is full to avoid slowing down admission requests.
+// Secrets are used to load the headers secret, it must be scoped to the Kyverno namespace
+func NewPublisher(configuration config.Configuration, secrets corev1client.SecretInterface, maxQueuedEvents int, log logr.Logger) Controller {
+	meter := global.MeterProvider().Meter(metrics.MeterName)
+	eventsMetric, err := meter.Int64Counter(
+		"kyverno_cloudevents",
+		metric.WithDescription("can be used to track the number of policy result CloudEvents sent, dropped or failed to be delivered to the configured sink"),
+	)
+	if err != nil {
+		log.Error(err, "Failed to create instrument, kyverno_cloudevents")
+	}
+	return &publisher{
+		configuration: configuration,
+		secrets:       secrets,
+		queue:         make(chan Event, maxQueuedEvents),
+		log:           log,
+		eventsMetric:  eventsMetric,
+	}
+}
+
+func (p *publisher) Publish(source event.Source, blocked bool, responses ...engineapi.EngineResponse) {
+	if !p.configuration.GetCloudEvents().Enabled() {
+		return
+	}
+	for _, e := range NewPolicyResultEvents(source, blocked, responses...) {
+		select {
+		case p.queue <- e:
+		default:
+			p.record(context.Background(), "dropped", 1)
+			p.log.V(4).Info("cloud events queue is full, dropping event", "id", e.ID, "subject", e.Subject)
+		}
+	}
+}
+
+func (p *publisher) Run(ctx context.Context, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		p.run(ctx)
+	}()
+}
+
+// run accumulates events until the batch is full or the flush interval has elapsed since the first buffered event
+func (p *publisher) run(ctx context.Context) {
+	logger := p.log
+	logger.Info("start")
+	defer logger.Info("shutting down")
+	var batch []Event
+	var flush <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			if len(batch) > 0 {
+				ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+				p.send(ctx, batch)
+				cancel()
+			}
+			p.closeTransport()
+			return
+		case e := <-p.queue:
+			cfg := p.configuration.GetCloudEvents()
+			batch = append(batch, e)
+			if len(batch) == 1 {
+				flush = time.After(cfg.FlushInterval.Duration)
+			}
+			if len(batch) >= cfg.BatchSize {
+				p.send(ctx, batch)
+				batch, flush = nil, nil
+			}
+		case <-flush:
+			p.send(ctx, batch)
+			batch, flush = nil, nil
+		}
+	}
+}
+
+// send delivers the events according to the current configuration, events are dropped if the sink has been removed
+func (p *publisher) send(ctx context.Context, events []Event) {
+	cfg := p.configuration.GetCloudEvents()
+	if !cfg.Enabled() {
+		p.record(ctx, "dropped", len(events))
+		return
+	}
+	transport, err := p.getTransport(cfg)
+	if err != nil {
+		p.log.Error(err, "failed to connect to cloud events sink", "sink", cfg.RedactedSink())
+		p.record(ctx, "failed", len(events))
+		return
+	}
+	headers, err := p.headers(ctx, cfg)
+	if err != nil {
+		p.log.Error(err, "failed to load cloud events headers", "secret", cfg.HeadersSecret)
+		p.record(ctx, "failed", len(events))
+		return
+	}
+	if cfg.BatchSize == 1 {
+		for _, e := range events {
+			p.deliver(ctx, cfg, transport, headers, []Event{e})
+		}
+		return
+	}
+	p.deliver(ctx, cfg, transport, headers, events)
+}
+
+// getTransport returns the transport of the configured sink, the previous transport is closed when the sink changes
+func (p *publisher) getTransport(cfg config.CloudEventsConfig) (transport, error) {
+	if p.transport != nil && p.sink == cfg.Sink {
+		return p.transport, nil
+	}
+	p.closeTransport()
+	transport, err := newTransport(cfg.Sink)
+	if err != nil {
+		return nil, err
+	}
+	p.transport, p.sink = transport, cfg.Sink
+	return transport, nil
+}
+
+func (p *publisher) closeTransport() {
+	if p.transport != nil {
+		p.transport.close()
+		p.transport, p.sink = nil, ""
+	}
+}
+
+// headers returns the configured headers, the entries of the headers secret take precedence
+func (p *publisher) headers(ctx context.Context, cfg config.CloudEventsConfig) (map[string]string, error) {
+	if cfg.HeadersSecret == "" {
+		return cfg.Headers, nil
+	}
+	if p.secrets == nil {
+		return nil, errors.New("secrets client not configured")
+	}
+	secret, err := p.secrets.Get(ctx, cfg.HeadersSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string, len(cfg.Headers)+len(secret.Data))
+	for key, value := range cfg.Headers {
+		headers[key] = value
+	}
+	for key, value := range secret.Data {
+		headers[key] = string(value)
+	}
+	return headers, nil
+}
+
+func (p *publisher) deliver(ctx context.Context, cfg config.CloudEventsConfig, transport transport, headers map[string]string, events []Event) {
+	backoff := wait.Backoff{
+		Duration: 500 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    cfg.MaxRetries + 1,
+		Cap:      30 * time.Second,
+	}
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		retry, err := transport.send(ctx, cfg, headers, events)
+		if err == nil {
+			return true, nil
+		}
+		lastErr = err
+		if !retry {
+			return false, err
+		}
+		p.log.V(3).Info("failed to send cloud events, retrying", "sink", cfg.RedactedSink(), "error", err.Error())
+		return false, nil
+	})
+	if err != nil {
+		if lastErr == nil {
+			lastErr = err
+		}
+		p.log.Error(lastErr, "failed to send cloud events", "sink", cfg.RedactedSink(), "events", len(events))
+		p.record(ctx, "failed", len(events))
+		return
+	}
+	p.record(ctx, "sent", len(events))
+}
+
+func (p *publisher) record(ctx context.Context, result string, count int) {
+	if p.eventsMetric != nil {
+		p.eventsMetric.Add(ctx, int64(count), metric.WithAttributes(attribute.String("result", result)))
+	}
+}
@@ -0,0 +1,247 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/event"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newResponse(rules ...engineapi.RuleResponse) engineapi.EngineResponse {
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "require-labels"}}
+	resource := unstructured.Unstructured{}
+	resource.SetAPIVersion("v1")
+	resource.SetKind("Pod")
+	resource.SetNamespace("default")
+	resource.SetName("nginx")
+	var policyResponse engineapi.PolicyResponse
+	for _, rule := range rules {
+		policyResponse.Add(engineapi.ExecutionStats{}, rule)
+	}
+	return engineapi.NewEngineResponse(resource, policy, nil).WithPolicyResponse(policyResponse)
+}
+
+func newConfiguration(cloudEvents string) config.Configuration {
+	configuration := config.NewDefaultConfiguration(false)
+	configuration.Load(&corev1.ConfigMap{Data: map[string]string{"cloudEvents": cloudEvents}})
+	return configuration
+}
+
+// sink records the events received by a test server
+type sink struct {
+	lock         sync.Mutex
+	contentTypes []string
+	headers      []http.Header
+	batches      [][]Event
+	statuses     []int
+}
+
+func (s *sink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.statuses) > 0 {
+		status := s.statuses[0]
+		s.statuses = s.statuses[1:]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+	}
+	var events []Event
+	if r.Header.Get("Content-Type") == batchContentType {
+		_ = json.NewDecoder(r.Body).Decode(&events)
+	} else {
+		var e Event
+		_ = json.NewDecoder(r.Body).Decode(&e)
+		events = append(events, e)
+	}
+	s.contentTypes = append(s.contentTypes, r.Header.Get("Content-Type"))
+	s.headers = append(s.headers, r.Header)
+	s.batches = append(s.batches, events)
+}
+
+func (s *sink) received() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	count := 0
+	for _, batch := range s.batches {
+		count += len(batch)
+	}
+	return count
+}
+
+func Test_NewPolicyResultEvents(t *testing.T) {
+	response := newResponse(
+		*engineapi.RulePass("check-team", engineapi.Validation, "validation rule 'check-team' passed."),
+		*engineapi.RuleFail("check-app", engineapi.Validation, "label app is required"),
+	)
+	events := NewPolicyResultEvents(event.AdmissionController, true, response)
+	assert.Equal(t, 2, len(events))
+	for _, e := range events {
+		assert.Equal(t, SpecVersion, e.SpecVersion)
+		assert.Equal(t, PolicyResultType, e.Type)
+		assert.Equal(t, "kyverno-admission", e.Source)
+		assert.Equal(t, "Pod/default/nginx", e.Subject)
+		assert.Assert(t, e.ID != "")
+		assert.Equal(t, "require-labels", e.Data.Policy)
+		assert.Equal(t, "nginx", e.Data.Resource.Name)
+	}
+	assert.Equal(t, "pass", events[0].Data.Status)
+	assert.Equal(t, false, events[0].Data.Blocked)
+	assert.Equal(t, "fail", events[1].Data.Status)
+	assert.Equal(t, true, events[1].Data.Blocked)
+	assert.Equal(t, "label app is required", events[1].Data.Message)
+	assert.Assert(t, events[0].ID != events[1].ID)
+}
+
+func Test_Publisher(t *testing.T) {
+	tests := []struct {
+		name         string
+		cloudEvents  string
+		statuses     []int
+		rules        int
+		wantReceived int
+		wantBatches  int
+		wantType     string
+	}{{
+		name:         "batched",
+		cloudEvents:  `{"batchSize": 2, "flushInterval": "100ms"}`,
+		rules:        3,
+		wantReceived: 3,
+		wantBatches:  2,
+		wantType:     batchContentType,
+	}, {
+		name:         "structured",
+		cloudEvents:  `{"batchSize": 1}`,
+		rules:        2,
+		wantReceived: 2,
+		wantBatches:  2,
+		wantType:     structuredContentType,
+	}, {
+		name:         "retried",
+		cloudEvents:  `{"batchSize": 1, "maxRetries": 2}`,
+		statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+		rules:        1,
+		wantReceived: 1,
+		wantBatches:  1,
+		wantType:     structuredContentType,
+	}, {
+		name:        "not retried",
+		cloudEvents: `{"batchSize": 1, "maxRetries": 2}`,
+		statuses:    []int{http.StatusBadRequest},
+		rules:       1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sink{statuses: tt.statuses}
+			server := httptest.NewServer(s)
+			defer server.Close()
+			var cloudEvents map[string]interface{}
+			assert.NilError(t, json.Unmarshal([]byte(tt.cloudEvents), &cloudEvents))
+			cloudEvents["sink"] = server.URL
+			data, err := json.Marshal(cloudEvents)
+			assert.NilError(t, err)
+			p := NewPublisher(newConfiguration(string(data)), nil, 10, logr.Discard())
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			p.Run(ctx, &wg)
+			var rules []engineapi.RuleResponse
+			for i := 0; i < tt.rules; i++ {
+				rules = append(rules, *engineapi.RuleFail("rule", engineapi.Validation, "failed"))
+			}
+			p.Publish(event.PolicyController, false, newResponse(rules...))
+			if tt.wantReceived > 0 {
+				assert.NilError(t, waitFor(func() bool { return s.received() == tt.wantReceived }))
+			} else {
+				time.Sleep(200 * time.Millisecond)
+			}
+			cancel()
+			wg.Wait()
+			assert.Equal(t, tt.wantReceived, s.received())
+			assert.Equal(t, tt.wantBatches, len(s.batches))
+			for _, contentType := range s.contentTypes {
+				assert.Equal(t, tt.wantType, contentType)
+			}
+		})
+	}
+}
+
+func Test_PublisherHeadersSecret(t *testing.T) {
+	s := &sink{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+	secrets := kubefake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloud-events", Namespace: "kyverno"},
+		Data:       map[string][]byte{"Authorization": []byte("Bearer token")},
+	}).CoreV1().Secrets("kyverno")
+	cloudEvents := fmt.Sprintf(`{"sink": %q, "batchSize": 1, "headers": {"Authorization": "Bearer other", "X-Team": "a"}, "headersSecret": "cloud-events"}`, server.URL)
+	p := NewPublisher(newConfiguration(cloudEvents), secrets, 10, logr.Discard())
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	p.Run(ctx, &wg)
+	p.Publish(event.PolicyController, false, newResponse(*engineapi.RulePass("rule", engineapi.Validation, "")))
+	assert.NilError(t, waitFor(func() bool { return s.received() == 1 }))
+	cancel()
+	wg.Wait()
+	assert.Equal(t, "Bearer token", s.headers[0].Get("Authorization"))
+	assert.Equal(t, "a", s.headers[0].Get("X-Team"))
+}
+
+func Test_PublisherHeadersSecretNotFound(t *testing.T) {
+	s := &sink{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+	secrets := kubefake.NewSimpleClientset().CoreV1().Secrets("kyverno")
+	cloudEvents := fmt.Sprintf(`{"sink": %q, "batchSize": 1, "headersSecret": "cloud-events"}`, server.URL)
+	p := NewPublisher(newConfiguration(cloudEvents), secrets, 10, logr.Discard()).(*publisher)
+	p.send(context.Background(), NewPolicyResultEvents(event.PolicyController, false, newResponse(*engineapi.RulePass("rule", engineapi.Validation, ""))))
+	assert.Equal(t, 0, s.received())
+}
+
+func Test_PublisherBackpressure(t *testing.T) {
+	p := NewPublisher(newConfiguration(`{"sink": "http://localhost"}`), nil, 2, logr.Discard()).(*publisher)
+	rules := []engineapi.RuleResponse{
+		*engineapi.RulePass("a", engineapi.Validation, ""),
+		*engineapi.RulePass("b", engineapi.Validation, ""),
+		*engineapi.RulePass("c", engineapi.Validation, ""),
+	}
+	// the publisher is not running, events beyond the queue capacity are dropped without blocking
+	p.Publish(event.PolicyController, false, newResponse(rules...))
+	assert.Equal(t, 2, len(p.queue))
+}
+
+func Test_PublisherDisabled(t *testing.T) {
+	p := NewPublisher(config.NewDefaultConfiguration(false), nil, 10, logr.Discard()).(*publisher)
+	p.Publish(event.PolicyController, false, newResponse(*engineapi.RulePass("a", engineapi.Validation, "")))
+	assert.Equal(t, 0, len(p.queue))
+}
+
+func waitFor(condition func() bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for !condition() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
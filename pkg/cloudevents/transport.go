@@ -0,0 +1,48 @@
+package cloudevents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kyverno/kyverno/pkg/config"
+)
+
+const (
+	// requestTimeout is the timeout of a single request sent to the sink
+	requestTimeout = 10 * time.Second
+	// contentTypeHeader is the header carrying the content type of broker messages
+	contentTypeHeader = "content-type"
+)
+
+// transport delivers events to a sink
+type transport interface {
+	// send delivers the events, it returns whether the delivery can be retried in case of failure
+	send(ctx context.Context, cfg config.CloudEventsConfig, headers map[string]string, events []Event) (bool, error)
+	// close releases the connections to the sink
+	close()
+}
+
+// newTransport creates the transport corresponding to the scheme of the sink
+func newTransport(sink string) (transport, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sink")
+	}
+	name := strings.Trim(u.Path, "/")
+	switch u.Scheme {
+	case "http", "https":
+		return &httpTransport{client: &http.Client{Timeout: requestTimeout}, sink: sink}, nil
+	case "nats":
+		server := *u
+		server.Path = ""
+		return newNatsTransport(server.String(), name)
+	case "kafka":
+		return newKafkaTransport(u.Host, name), nil
+	default:
+		return nil, fmt.Errorf("unsupported sink scheme %s", u.Scheme)
+	}
+}
@@ -0,0 +1,130 @@
+package cloudevents
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/segmentio/kafka-go"
+	"gotest.tools/assert"
+)
+
+func Test_newTransport(t *testing.T) {
+	transport, err := newTransport("https://broker.example.com/events")
+	assert.NilError(t, err)
+	assert.Equal(t, "https://broker.example.com/events", transport.(*httpTransport).sink)
+	transport, err = newTransport("kafka://localhost:9092/kyverno-results")
+	assert.NilError(t, err)
+	assert.Equal(t, "kyverno-results", transport.(*kafkaTransport).writer.Topic)
+	assert.Equal(t, "localhost:9092", transport.(*kafkaTransport).writer.Addr.String())
+	transport.close()
+	_, err = newTransport("amqp://localhost:5672/kyverno")
+	assert.ErrorContains(t, err, "unsupported sink scheme amqp")
+}
+
+func Test_natsMessages(t *testing.T) {
+	events := []Event{{ID: "1", Subject: "Pod/default/a"}, {ID: "2", Subject: "Pod/default/b"}}
+	messages, err := natsMessages("kyverno.results", map[string]string{"X-Team": "a"}, events)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(messages))
+	for _, message := range messages {
+		assert.Equal(t, "kyverno.results", message.Subject)
+		assert.Equal(t, structuredContentType, message.Header.Get(contentTypeHeader))
+		assert.Equal(t, "a", message.Header.Get("X-Team"))
+	}
+	assert.Assert(t, len(messages[0].Data) > 0)
+}
+
+func Test_kafkaMessages(t *testing.T) {
+	events := []Event{{ID: "1", Subject: "Pod/default/a"}}
+	messages, err := kafkaMessages(map[string]string{"X-Team": "a"}, events)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, "Pod/default/a", string(messages[0].Key))
+	assert.DeepEqual(t, []kafka.Header{
+		{Key: "X-Team", Value: []byte("a")},
+		{Key: contentTypeHeader, Value: []byte(structuredContentType)},
+	}, messages[0].Headers)
+}
+
+// natsServer is a minimal nats server recording the published messages
+type natsServer struct {
+	listener net.Listener
+	lock     sync.Mutex
+	messages []string
+}
+
+func newNatsServer(t *testing.T) *natsServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	s := &natsServer{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *natsServer) serve(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprintf(conn, "INFO {\"server_id\":\"test\",\"version\":\"2.9.0\",\"proto\":1,\"headers\":true,\"max_payload\":1048576}\r\n")
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "PING":
+			fmt.Fprintf(conn, "PONG\r\n")
+		case "HPUB", "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			s.lock.Lock()
+			s.messages = append(s.messages, fields[1]+" "+string(payload[:size]))
+			s.lock.Unlock()
+		}
+	}
+}
+
+func (s *natsServer) received() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.messages...)
+}
+
+func Test_natsTransport(t *testing.T) {
+	server := newNatsServer(t)
+	defer server.listener.Close()
+	transport, err := newTransport("nats://" + server.listener.Addr().String() + "/kyverno.results")
+	assert.NilError(t, err)
+	defer transport.close()
+	events := []Event{{ID: "1", Subject: "Pod/default/a"}, {ID: "2", Subject: "Pod/default/b"}}
+	_, err = transport.send(context.Background(), config.CloudEventsConfig{BatchSize: 2}, nil, events)
+	assert.NilError(t, err)
+	messages := server.received()
+	assert.Equal(t, 2, len(messages))
+	for _, message := range messages {
+		assert.Assert(t, strings.HasPrefix(message, "kyverno.results "))
+		assert.Assert(t, strings.Contains(message, structuredContentType))
+	}
+}
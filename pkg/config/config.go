@@ -83,6 +83,10 @@ const (
 	generateSuccessEvents         = "generateSuccessEvents"
 	webhooks                      = "webhooks"
 	webhookAnnotations            = "webhookAnnotations"
+	cloudEvents                   = "cloudEvents"
+	managedResourcesProtection    = "managedResourcesProtection"
+	admissionExplain              = "admissionExplain"
+	admissionExplainValues        = "admissionExplainValues"
 )
 
 var (
@@ -102,6 +106,10 @@ var (
 	kyvernoMetricsConfigMapName = osutils.GetEnvWithFallback("METRICS_CONFIG", "kyverno-metrics")
 	// kyvernoDryRunNamespace is the namespace for DryRun option of YAML verification
 	kyvernoDryrunNamespace = osutils.GetEnvWithFallback("KYVERNO_DRYRUN_NAMESPACE", "kyverno-dryrun")
+	// kyvernoTLSSecretName is the name of an externally managed secret containing the webhook certificate, empty if Kyverno manages certificates
+	kyvernoTLSSecretName = osutils.GetEnvWithFallback("KYVERNO_TLS_SECRET_NAME", "")
+	// kyvernoCASecretName is the name of an externally managed secret containing the CA bundle, defaults to the TLS secret
+	kyvernoCASecretName = osutils.GetEnvWithFallback("KYVERNO_CA_SECRET_NAME", "")
 )
 
 func KyvernoNamespace() string {
@@ -136,6 +144,14 @@ func KyvernoMetricsConfigMapName() string {
 	return kyvernoMetricsConfigMapName
 }
 
+func KyvernoTLSSecretName() string {
+	return kyvernoTLSSecretName
+}
+
+func KyvernoCASecretName() string {
+	return kyvernoCASecretName
+}
+
 func KyvernoUserName(serviceaccount string) string {
 	return fmt.Sprintf("system:serviceaccount:%s:%s", kyvernoNamespace, serviceaccount)
 }
@@ -156,6 +172,14 @@ type Configuration interface {
 	GetWebhooks() []WebhookConfig
 	// GetWebhookAnnotations returns annotations to set on webhook configs
 	GetWebhookAnnotations() map[string]string
+	// GetCloudEvents returns the CloudEvents sink configuration
+	GetCloudEvents() CloudEventsConfig
+	// GetManagedResourcesProtection returns the access rules to resources managed by Kyverno
+	GetManagedResourcesProtection() ProtectionConfig
+	// GetAdmissionExplain returns if the explain annotation is honored at admission
+	GetAdmissionExplain() bool
+	// GetAdmissionExplainValues returns if resolved values are included in admission explanations
+	GetAdmissionExplainValues() bool
 	// Load loads configuration from a configmap
 	Load(*corev1.ConfigMap)
 	// OnChanged adds a callback to be invoked when the configuration is reloaded
@@ -173,6 +197,10 @@ type configuration struct {
 	generateSuccessEvents         bool
 	webhooks                      []WebhookConfig
 	webhookAnnotations            map[string]string
+	cloudEvents                   CloudEventsConfig
+	managedResourcesProtection    ProtectionConfig
+	admissionExplain              bool
+	admissionExplainValues        bool
 	mux                           sync.RWMutex
 	callbacks                     []func()
 }
@@ -291,6 +319,30 @@ func (cd *configuration) GetWebhookAnnotations() map[string]string {
 	return cd.webhookAnnotations
 }
 
+func (cd *configuration) GetCloudEvents() CloudEventsConfig {
+	cd.mux.RLock()
+	defer cd.mux.RUnlock()
+	return cd.cloudEvents
+}
+
+func (cd *configuration) GetManagedResourcesProtection() ProtectionConfig {
+	cd.mux.RLock()
+	defer cd.mux.RUnlock()
+	return cd.managedResourcesProtection
+}
+
+func (cd *configuration) GetAdmissionExplain() bool {
+	cd.mux.RLock()
+	defer cd.mux.RUnlock()
+	return cd.admissionExplain
+}
+
+func (cd *configuration) GetAdmissionExplainValues() bool {
+	cd.mux.RLock()
+	defer cd.mux.RUnlock()
+	return cd.admissionExplainValues
+}
+
 func (cd *configuration) Load(cm *corev1.ConfigMap) {
 	if cm != nil {
 		cd.load(cm)
@@ -317,6 +369,10 @@ func (cd *configuration) load(cm *corev1.ConfigMap) {
 	cd.generateSuccessEvents = false
 	cd.webhooks = nil
 	cd.webhookAnnotations = nil
+	cd.cloudEvents = CloudEventsConfig{}
+	cd.managedResourcesProtection = ProtectionConfig{}
+	cd.admissionExplain = false
+	cd.admissionExplainValues = false
 	// load filters
 	cd.filters = parseKinds(data[resourceFilters])
 	logger.Info("filters configured", "filters", cd.filters)
@@ -421,6 +477,62 @@ func (cd *configuration) load(cm *corev1.ConfigMap) {
 			logger.Info("webhookAnnotations configured")
 		}
 	}
+	// load cloud events
+	cloudEvents, ok := data[cloudEvents]
+	if !ok {
+		logger.Info("cloudEvents not set")
+	} else {
+		// the configuration is not logged as headers and sink credentials are sensitive
+		cloudEvents, err := parseCloudEvents(cloudEvents)
+		if err != nil {
+			logger.Error(err, "failed to parse cloud events")
+		} else {
+			cd.cloudEvents = cloudEvents
+			logger.Info("cloudEvents configured", "sink", cloudEvents.RedactedSink(), "headersSecret", cloudEvents.HeadersSecret)
+		}
+	}
+	// load managed resources protection
+	managedResourcesProtection, ok := data[managedResourcesProtection]
+	if !ok {
+		logger.Info("managedResourcesProtection not set")
+	} else {
+		logger := logger.WithValues("managedResourcesProtection", managedResourcesProtection)
+		managedResourcesProtection, err := parseManagedResourcesProtection(managedResourcesProtection)
+		if err != nil {
+			logger.Error(err, "failed to parse managed resources protection")
+		} else {
+			cd.managedResourcesProtection = managedResourcesProtection
+			logger.Info("managedResourcesProtection configured")
+		}
+	}
+	// load admission explain
+	admissionExplain, ok := data[admissionExplain]
+	if !ok {
+		logger.Info("admissionExplain not set")
+	} else {
+		logger := logger.WithValues("admissionExplain", admissionExplain)
+		admissionExplain, err := strconv.ParseBool(admissionExplain)
+		if err != nil {
+			logger.Error(err, "admissionExplain is not a boolean")
+		} else {
+			cd.admissionExplain = admissionExplain
+			logger.Info("admissionExplain configured")
+		}
+	}
+	// load admission explain values
+	admissionExplainValues, ok := data[admissionExplainValues]
+	if !ok {
+		logger.Info("admissionExplainValues not set")
+	} else {
+		logger := logger.WithValues("admissionExplainValues", admissionExplainValues)
+		admissionExplainValues, err := strconv.ParseBool(admissionExplainValues)
+		if err != nil {
+			logger.Error(err, "admissionExplainValues is not a boolean")
+		} else {
+			cd.admissionExplainValues = admissionExplainValues
+			logger.Info("admissionExplainValues configured")
+		}
+	}
 }
 
 func (cd *configuration) unload() {
@@ -435,6 +547,10 @@ func (cd *configuration) unload() {
 	cd.generateSuccessEvents = false
 	cd.webhooks = nil
 	cd.webhookAnnotations = nil
+	cd.cloudEvents = CloudEventsConfig{}
+	cd.managedResourcesProtection = ProtectionConfig{}
+	cd.admissionExplain = false
+	cd.admissionExplainValues = false
 	logger.Info("configuration unloaded")
 }
 
@@ -2,8 +2,12 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +48,129 @@ func parseExclusions(in string) (exclusions, inclusions []string) {
 	return
 }
 
+const (
+	defaultCloudEventsBatchSize     = 100
+	defaultCloudEventsFlushInterval = 5 * time.Second
+	defaultCloudEventsMaxRetries    = 3
+)
+
+// CloudEventsConfig configures the publication of policy results as CloudEvents
+type CloudEventsConfig struct {
+	// Sink is the endpoint events are sent to, events are not published if empty.
+	// Events are posted to http and https URLs, published on the subject in the path of nats URLs
+	// (nats://host:4222/subject) and written to the topic in the path of kafka URLs (kafka://host:9092/topic)
+	Sink string `json:"sink,omitempty"`
+	// BatchSize is the maximum number of events sent in a single request, events are sent
+	// one by one in structured mode if set to 1, otherwise in batched mode
+	BatchSize int `json:"batchSize,omitempty"`
+	// FlushInterval is the maximum time events are buffered before being sent
+	FlushInterval metav1.Duration `json:"flushInterval,omitempty"`
+	// MaxRetries is the number of times a failed request is retried before events are dropped
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// Headers are added to every request or message sent to the sink
+	Headers map[string]string `json:"headers,omitempty"`
+	// HeadersSecret is the name of a Secret in the Kyverno namespace, its entries are added as headers
+	// to every request or message sent to the sink and take precedence over Headers
+	HeadersSecret string `json:"headersSecret,omitempty"`
+}
+
+// Enabled returns true if a sink is configured
+func (c CloudEventsConfig) Enabled() bool {
+	return c.Sink != ""
+}
+
+// RedactedSink returns the sink with the password of the URL replaced, it is safe to log
+func (c CloudEventsConfig) RedactedSink() string {
+	sink, err := url.Parse(c.Sink)
+	if err != nil {
+		return ""
+	}
+	return sink.Redacted()
+}
+
+func parseCloudEvents(in string) (CloudEventsConfig, error) {
+	out := CloudEventsConfig{
+		BatchSize:     defaultCloudEventsBatchSize,
+		FlushInterval: metav1.Duration{Duration: defaultCloudEventsFlushInterval},
+		MaxRetries:    defaultCloudEventsMaxRetries,
+	}
+	if err := json.Unmarshal([]byte(in), &out); err != nil {
+		return CloudEventsConfig{}, err
+	}
+	if out.Sink != "" {
+		sink, err := url.Parse(out.Sink)
+		if err != nil {
+			// the parse error is not returned as it contains the sink credentials
+			return CloudEventsConfig{}, errors.New("sink must be a valid URL")
+		}
+		switch sink.Scheme {
+		case "http", "https":
+		case "nats", "kafka":
+			if sink.Host == "" || strings.Trim(sink.Path, "/") == "" {
+				return CloudEventsConfig{}, fmt.Errorf("%s sink must contain a host and a subject or topic, e.g. %s://host/name", sink.Scheme, sink.Scheme)
+			}
+		default:
+			return CloudEventsConfig{}, errors.New("sink must be an http, https, nats or kafka URL")
+		}
+	}
+	if out.BatchSize <= 0 {
+		return CloudEventsConfig{}, errors.New("batchSize must be greater than zero")
+	}
+	if out.FlushInterval.Duration <= 0 {
+		return CloudEventsConfig{}, errors.New("flushInterval must be greater than zero")
+	}
+	if out.MaxRetries < 0 {
+		return CloudEventsConfig{}, errors.New("maxRetries must not be negative")
+	}
+	return out, nil
+}
+
+// ProtectionConfig configures the access to resources managed by Kyverno when managed resources protection is enabled
+type ProtectionConfig struct {
+	// Allow holds the rules granting access to managed resources to other users than Kyverno
+	Allow []ProtectionAllowRule `json:"allow,omitempty"`
+}
+
+// ProtectionAllowRule grants access to managed resources, a request is allowed if it matches all the constraints of the rule
+type ProtectionAllowRule struct {
+	// Usernames are the names of the users allowed by the rule, wildcards are supported
+	Usernames []string `json:"usernames,omitempty"`
+	// Groups are the groups allowed by the rule, wildcards are supported
+	Groups []string `json:"groups,omitempty"`
+	// ServiceAccounts are the service accounts allowed by the rule in the namespace:name form, wildcards are supported
+	ServiceAccounts []string `json:"serviceAccounts,omitempty"`
+	// Policies restricts the rule to resources generated by the given policies,
+	// in the name form for cluster policies and namespace/name form for namespaced policies
+	Policies []string `json:"policies,omitempty"`
+	// Operations restricts the rule to the given operations (CREATE, UPDATE, DELETE), all operations are allowed if empty
+	Operations []string `json:"operations,omitempty"`
+	// Fields restricts the rule to updates changing only the given dot separated fields (e.g. metadata.labels)
+	Fields []string `json:"fields,omitempty"`
+}
+
+func parseManagedResourcesProtection(in string) (ProtectionConfig, error) {
+	var out ProtectionConfig
+	if err := json.Unmarshal([]byte(in), &out); err != nil {
+		return ProtectionConfig{}, err
+	}
+	for _, rule := range out.Allow {
+		if len(rule.Usernames) == 0 && len(rule.Groups) == 0 && len(rule.ServiceAccounts) == 0 {
+			return ProtectionConfig{}, errors.New("allow rules must define at least one username, group or service account")
+		}
+		for _, operation := range rule.Operations {
+			if operation != "CREATE" && operation != "UPDATE" && operation != "DELETE" {
+				return ProtectionConfig{}, fmt.Errorf("invalid operation %s, must be one of CREATE, UPDATE or DELETE", operation)
+			}
+		}
+		for _, field := range rule.Fields {
+			if field == "" || strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") {
+				return ProtectionConfig{}, fmt.Errorf("invalid field %q", field)
+			}
+		}
+	}
+	return out, nil
+}
+
 func parseWebhookAnnotations(in string) (map[string]string, error) {
 	var out map[string]string
 	if err := json.Unmarshal([]byte(in), &out); err != nil {
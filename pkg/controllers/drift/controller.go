@@ -0,0 +1,204 @@
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov1beta1 "github.com/kyverno/kyverno/api/kyverno/v1beta1"
+	"github.com/kyverno/kyverno/pkg/autogen"
+	"github.com/kyverno/kyverno/pkg/background/common"
+	"github.com/kyverno/kyverno/pkg/background/generate"
+	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
+	kyvernov1informers "github.com/kyverno/kyverno/pkg/client/informers/externalversions/kyverno/v1"
+	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/controllers"
+	"github.com/kyverno/kyverno/pkg/event"
+	controllerutils "github.com/kyverno/kyverno/pkg/utils/controller"
+	"go.uber.org/multierr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// Workers is the number of workers for this controller
+	Workers        = 1
+	ControllerName = "drift-controller"
+	maxRetries     = 5
+)
+
+type controller struct {
+	// clients
+	kyvernoClient versioned.Interface
+
+	// listers
+	cpolLister kyvernov1listers.ClusterPolicyLister
+	polLister  kyvernov1listers.PolicyLister
+
+	// queue
+	queue workqueue.RateLimitingInterface
+
+	detector  *generate.DriftDetector
+	eventGen  event.Interface
+	interval  time.Duration
+	remediate bool
+}
+
+// NewController returns a controller checking the downstream resources of synchronized generate rules and the targets
+// of mutateExisting rules every interval, drifted resources are reported with events and their expected state is re-applied if remediate is true
+func NewController(
+	kyvernoClient versioned.Interface,
+	cpolInformer kyvernov1informers.ClusterPolicyInformer,
+	polInformer kyvernov1informers.PolicyInformer,
+	detector *generate.DriftDetector,
+	eventGen event.Interface,
+	interval time.Duration,
+	remediate bool,
+) controllers.Controller {
+	c := controller{
+		kyvernoClient: kyvernoClient,
+		cpolLister:    cpolInformer.Lister(),
+		polLister:     polInformer.Lister(),
+		queue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), ControllerName),
+		detector:      detector,
+		eventGen:      eventGen,
+		interval:      interval,
+		remediate:     remediate,
+	}
+	return &c
+}
+
+func (c *controller) Run(ctx context.Context, workers int) {
+	controllerutils.Run(ctx, logger, ControllerName, time.Second, c.queue, workers, maxRetries, c.reconcile, c.ticker)
+}
+
+func (c *controller) ticker(ctx context.Context, logger logr.Logger) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.enqueuePolicies(logger)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *controller) enqueuePolicies(logger logr.Logger) {
+	var policies []kyvernov1.PolicyInterface
+	cpols, err := c.cpolLister.List(labels.Everything())
+	if err != nil {
+		logger.Error(err, "failed to list cluster policies")
+	}
+	for _, policy := range cpols {
+		policies = append(policies, policy)
+	}
+	pols, err := c.polLister.List(labels.Everything())
+	if err != nil {
+		logger.Error(err, "failed to list policies")
+	}
+	for _, policy := range pols {
+		policies = append(policies, policy)
+	}
+	for _, policy := range policies {
+		if !hasDriftCheckedRules(policy) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(policy)
+		if err != nil {
+			logger.Error(err, "failed to compute policy key", "name", policy.GetName())
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *controller) reconcile(ctx context.Context, logger logr.Logger, key, namespace, name string) error {
+	policy, err := c.loadPolicy(namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	drifts, err := c.detector.Detect(ctx, policy)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, drift := range drifts {
+		logger.V(2).Info("resource drifted", "type", drift.Type, "rule", drift.Rule, "downstream", common.ResourceSpecFromUnstructured(drift.Downstream).String(), "reason", drift.Reason)
+		source := event.GeneratePolicyController
+		if drift.Type == kyvernov1beta1.Mutate {
+			source = event.MutateExistingController
+		}
+		c.eventGen.Add(event.NewResourceDriftedEvent(source, policy, drift.Rule, drift.Downstream, drift.Reason))
+		if c.remediate {
+			if err := c.createUpdateRequest(ctx, policy, drift); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (c *controller) loadPolicy(namespace, name string) (kyvernov1.PolicyInterface, error) {
+	if namespace == "" {
+		return c.cpolLister.Get(name)
+	} else {
+		return c.polLister.Policies(namespace).Get(name)
+	}
+}
+
+// createUpdateRequest creates an update request re-applying the generate or mutateExisting rule for the trigger of the drifted resource
+func (c *controller) createUpdateRequest(ctx context.Context, policy kyvernov1.PolicyInterface, drift generate.Drift) error {
+	policyKey := common.PolicyKey(policy.GetNamespace(), policy.GetName())
+	urLabels := common.GenerateLabelsSet(policyKey, drift.Trigger)
+	if drift.Type == kyvernov1beta1.Mutate {
+		urLabels = common.MutateLabelsSet(policyKey, drift.Trigger)
+	}
+	ur := &kyvernov1beta1.UpdateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ur-",
+			Namespace:    config.KyvernoNamespace(),
+			Labels:       urLabels,
+		},
+		Spec: kyvernov1beta1.UpdateRequestSpec{
+			Type:     drift.Type,
+			Policy:   policyKey,
+			Rule:     drift.Rule,
+			Resource: drift.Trigger,
+		},
+	}
+	created, err := c.kyvernoClient.KyvernoV1beta1().UpdateRequests(config.KyvernoNamespace()).Create(ctx, ur, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	updated := created.DeepCopy()
+	updated.Status = kyvernov1beta1.UpdateRequestStatus{
+		State: kyvernov1beta1.Pending,
+	}
+	if drift.Type == kyvernov1beta1.Generate {
+		updated.Status.GeneratedResources = []kyvernov1.ResourceSpec{common.ResourceSpecFromUnstructured(drift.Downstream)}
+	}
+	_, err = c.kyvernoClient.KyvernoV1beta1().UpdateRequests(config.KyvernoNamespace()).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+func hasDriftCheckedRules(policy kyvernov1.PolicyInterface) bool {
+	for _, rule := range autogen.ComputeRules(policy) {
+		if rule.IsMutateExisting() {
+			return true
+		}
+		if _, sync := rule.GetGenerateTypeAndSync(); sync {
+			return true
+		}
+	}
+	return false
+}
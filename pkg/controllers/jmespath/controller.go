@@ -0,0 +1,75 @@
+package jmespath
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	kyvernov2alpha1informers "github.com/kyverno/kyverno/pkg/client/informers/externalversions/kyverno/v2alpha1"
+	kyvernov2alpha1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v2alpha1"
+	"github.com/kyverno/kyverno/pkg/controllers"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	controllerutils "github.com/kyverno/kyverno/pkg/utils/controller"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// Workers is the number of workers for this controller
+	Workers        = 1
+	ControllerName = "jmespath-controller"
+	maxRetries     = 10
+	// functionsKey is the single queue key, functions are always registered all together
+	functionsKey = "functions"
+)
+
+type Controller interface {
+	controllers.Controller
+	WarmUp() error
+}
+
+type controller struct {
+	// listers
+	functionLister kyvernov2alpha1listers.JMESPathFunctionLister
+
+	// queue
+	queue workqueue.RateLimitingInterface
+}
+
+// NewController returns a controller registering user defined JMESPath functions
+func NewController(functionInformer kyvernov2alpha1informers.JMESPathFunctionInformer) Controller {
+	c := controller{
+		functionLister: functionInformer.Lister(),
+		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), ControllerName),
+	}
+	controllerutils.AddKeyedEventHandlers(logger, functionInformer.Informer(), c.queue, func(interface{}) (interface{}, error) {
+		return functionsKey, nil
+	})
+	return &c
+}
+
+func (c *controller) WarmUp() error {
+	return c.register()
+}
+
+func (c *controller) Run(ctx context.Context, workers int) {
+	controllerutils.Run(ctx, logger, ControllerName, time.Second, c.queue, workers, maxRetries, c.reconcile)
+}
+
+func (c *controller) reconcile(ctx context.Context, logger logr.Logger, _, _, _ string) error {
+	return c.register()
+}
+
+func (c *controller) register() error {
+	functions, err := c.functionLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var entries []kyvernov2alpha1.JMESPathFunction
+	for _, function := range functions {
+		entries = append(entries, *function)
+	}
+	jmespath.SetCustomFunctions(entries...)
+	return nil
+}
@@ -0,0 +1,5 @@
+package jmespath
+
+import "github.com/kyverno/kyverno/pkg/logging"
+
+var logger = logging.ControllerLogger(ControllerName)
@@ -12,6 +12,7 @@ import (
 	kyvernov1informers "github.com/kyverno/kyverno/pkg/client/informers/externalversions/kyverno/v1"
 	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/controllers"
 	"github.com/kyverno/kyverno/pkg/controllers/report/resource"
@@ -64,9 +65,10 @@ type controller struct {
 	forceDelay    time.Duration
 
 	// config
-	config   config.Configuration
-	jp       jmespath.Interface
-	eventGen event.Interface
+	config    config.Configuration
+	jp        jmespath.Interface
+	eventGen  event.Interface
+	publisher cloudevents.Interface
 }
 
 func NewController(
@@ -82,6 +84,7 @@ func NewController(
 	config config.Configuration,
 	jp jmespath.Interface,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 ) controllers.Controller {
 	bgscanr := metadataFactory.ForResource(kyvernov1alpha2.SchemeGroupVersion.WithResource("backgroundscanreports"))
 	cbgscanr := metadataFactory.ForResource(kyvernov1alpha2.SchemeGroupVersion.WithResource("clusterbackgroundscanreports"))
@@ -101,6 +104,7 @@ func NewController(
 		config:         config,
 		jp:             jp,
 		eventGen:       eventGen,
+		publisher:      publisher,
 	}
 	controllerutils.AddDefaultEventHandlers(logger, bgscanr.Informer(), queue)
 	controllerutils.AddDefaultEventHandlers(logger, cbgscanr.Informer(), queue)
@@ -312,6 +316,7 @@ func (c *controller) reconcileReport(
 				} else if result.EngineResponse != nil {
 					ruleResults = append(ruleResults, reportutils.EngineResponseToReportResults(*result.EngineResponse)...)
 					utils.GenerateEvents(logger, c.eventGen, c.config, *result.EngineResponse)
+					c.publisher.Publish(event.PolicyController, false, *result.EngineResponse)
 				}
 			}
 		}
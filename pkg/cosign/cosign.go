@@ -57,6 +57,10 @@ func (v *cosignVerifier) VerifySignature(ctx context.Context, opts images.Option
 			}
 			return client.VerifyImageSignatures(ctx, ref, cosignOpts)
 		},
+		trace.WithAttributes(
+			tracing.ImageReferenceKey.String(opts.ImageRef),
+			tracing.ImageVerifierKey.String("cosign"),
+		),
 	)
 	if err != nil {
 		logger.Info("image verification failed", "error", err.Error())
@@ -248,6 +252,10 @@ func (v *cosignVerifier) FetchAttestations(ctx context.Context, opts images.Opti
 			}
 			return client.VerifyImageAttestations(ctx, ref, cosignOpts)
 		},
+		trace.WithAttributes(
+			tracing.ImageReferenceKey.String(opts.ImageRef),
+			tracing.ImageVerifierKey.String("cosign"),
+		),
 	)
 	if err != nil {
 		msg := err.Error()
@@ -13,6 +13,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
 	"github.com/kyverno/kyverno/pkg/engine/variables"
 	"github.com/kyverno/kyverno/pkg/registryclient"
+	"github.com/kyverno/kyverno/pkg/tracing"
 )
 
 func LoadVariable(logger logr.Logger, jp jmespath.Interface, entry kyvernov1.ContextEntry, ctx enginecontext.Interface) (err error) {
@@ -103,7 +104,7 @@ func LoadAPIData(ctx context.Context, jp jmespath.Interface, logger logr.Logger,
 func LoadConfigMap(ctx context.Context, logger logr.Logger, entry kyvernov1.ContextEntry, enginectx enginecontext.Interface, resolver ConfigmapResolver) error {
 	data, err := fetchConfigMap(ctx, logger, entry, enginectx, resolver)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve config map for context entry %s: %v", entry.Name, err)
+		return fmt.Errorf("failed to retrieve config map for context entry %s: %w", entry.Name, err)
 	}
 	err = enginectx.AddContextEntry(entry.Name, data)
 	if err != nil {
@@ -121,6 +122,7 @@ func fetchImageData(ctx context.Context, jp jmespath.Interface, rclient registry
 	if !ok {
 		return nil, fmt.Errorf("invalid image reference %s, image reference must be a string", ref)
 	}
+	tracing.SetAttributes(ctx, tracing.ImageReferenceKey.String(refString))
 	path, err := variables.SubstituteAll(logger, enginectx, entry.ImageRegistry.JMESPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to substitute variables in context entry %s %s: %v", entry.Name, entry.ImageRegistry.JMESPath, err)
@@ -216,7 +218,7 @@ func fetchConfigMap(ctx context.Context, logger logr.Logger, entry kyvernov1.Con
 	}
 	obj, err := resolver.Get(ctx, namespace.(string), name.(string))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get configmap %s/%s : %v", namespace, name, err)
+		return nil, fmt.Errorf("failed to get configmap %s/%s : %w", namespace, name, err)
 	}
 	// extract configmap data
 	contextData["data"] = obj.Data
@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
@@ -11,8 +13,33 @@ import (
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
 	"github.com/kyverno/kyverno/pkg/logging"
 	"github.com/kyverno/kyverno/pkg/registryclient"
+	"github.com/kyverno/kyverno/pkg/tracing"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// context entry types, used to identify the loaders in traces and metrics
+const (
+	ContextEntryTypeConfigMap     = "configMap"
+	ContextEntryTypeAPICall       = "apiCall"
+	ContextEntryTypeImageRegistry = "imageRegistry"
+	ContextEntryTypeVariable      = "variable"
+)
+
+// causes of the errors reported when loading context entries
+const (
+	ContextEntryErrorTimeout   = "timeout"
+	ContextEntryErrorNotFound  = "not_found"
+	ContextEntryErrorForbidden = "forbidden"
+	ContextEntryErrorOther     = "other"
+)
+
+// ContextLoaderMetrics records the metrics of context entries loading, it is implemented by metrics.MetricsConfigManager
+type ContextLoaderMetrics interface {
+	RecordContextEntryDuration(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, duration float64)
+	RecordContextEntryErrors(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, cause string)
+}
+
 // ContextLoaderFactory provides a ContextLoader given a policy context and rule name
 type ContextLoaderFactory = func(policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) ContextLoader
 
@@ -30,11 +57,15 @@ type ContextLoader interface {
 
 func DefaultContextLoaderFactory(
 	cmResolver ConfigmapResolver,
+	metrics ContextLoaderMetrics,
 ) ContextLoaderFactory {
 	return func(policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) ContextLoader {
 		return &contextLoader{
 			logger:     logging.WithName("DefaultContextLoaderFactory"),
 			cmResolver: cmResolver,
+			metrics:    metrics,
+			policy:     policy,
+			rule:       rule.Name,
 		}
 	}
 }
@@ -42,6 +73,9 @@ func DefaultContextLoaderFactory(
 type contextLoader struct {
 	logger     logr.Logger
 	cmResolver ConfigmapResolver
+	metrics    ContextLoaderMetrics
+	policy     kyvernov1.PolicyInterface
+	rule       string
 }
 
 func (l *contextLoader) Load(
@@ -72,34 +106,105 @@ func (l *contextLoader) newDeferredLoader(
 	jsonContext enginecontext.Interface,
 ) enginecontext.DeferredLoader {
 	if entry.ConfigMap != nil {
-		return func() error {
-			if err := LoadConfigMap(ctx, l.logger, entry, jsonContext, l.cmResolver); err != nil {
-				return err
-			}
-			return nil
-		}
+		return withTimeout(ctx, entry, func(ctx context.Context) error {
+			return l.instrument(ctx, entry, ContextEntryTypeConfigMap, func(ctx context.Context) error {
+				return LoadConfigMap(ctx, l.logger, entry, jsonContext, l.cmResolver)
+			})
+		})
 	} else if entry.APICall != nil {
-		return func() error {
-			if err := LoadAPIData(ctx, jp, l.logger, entry, jsonContext, client); err != nil {
-				return err
-			}
-			return nil
-		}
+		return withTimeout(ctx, entry, func(ctx context.Context) error {
+			return l.instrument(ctx, entry, ContextEntryTypeAPICall, func(ctx context.Context) error {
+				return LoadAPIData(ctx, jp, l.logger, entry, jsonContext, client)
+			})
+		})
 	} else if entry.ImageRegistry != nil {
-		return func() error {
-			if err := LoadImageData(ctx, jp, rclient, l.logger, entry, jsonContext); err != nil {
-				return err
-			}
-			return nil
-		}
+		return withTimeout(ctx, entry, func(ctx context.Context) error {
+			return l.instrument(ctx, entry, ContextEntryTypeImageRegistry, func(ctx context.Context) error {
+				return LoadImageData(ctx, jp, rclient, l.logger, entry, jsonContext)
+			})
+		})
 	} else if entry.Variable != nil {
 		return func() error {
-			if err := LoadVariable(l.logger, jp, entry, jsonContext); err != nil {
-				return err
-			}
-			return nil
+			return l.instrument(ctx, entry, ContextEntryTypeVariable, func(ctx context.Context) error {
+				return LoadVariable(l.logger, jp, entry, jsonContext)
+			})
 		}
 	}
+	return nil
+}
 
+// instrument runs the loader of a context entry in a child span attributed with the policy, rule and entry,
+// and records the loading duration and errors
+func (l *contextLoader) instrument(ctx context.Context, entry kyvernov1.ContextEntry, entryType string, load func(context.Context) error) error {
+	var policyName, policyNamespace string
+	if l.policy != nil {
+		policyName = l.policy.GetName()
+		policyNamespace = l.policy.GetNamespace()
+	}
+	startTime := time.Now()
+	err := tracing.ChildSpan1(
+		ctx,
+		"pkg/engine",
+		fmt.Sprintf("CONTEXT %s", entry.Name),
+		func(ctx context.Context, span trace.Span) error {
+			err := load(ctx)
+			tracing.SetSpanStatus(span, err)
+			return err
+		},
+		trace.WithAttributes(
+			tracing.PolicyNameKey.String(policyName),
+			tracing.PolicyNamespaceKey.String(policyNamespace),
+			tracing.RuleNameKey.String(l.rule),
+			tracing.ContextEntryNameKey.String(entry.Name),
+			tracing.ContextEntryTypeKey.String(entryType),
+		),
+	)
+	if l.metrics != nil {
+		if policyNamespace == "" {
+			policyNamespace = "-"
+		}
+		l.metrics.RecordContextEntryDuration(ctx, entryType, policyNamespace, policyName, l.rule, time.Since(startTime).Seconds())
+		if err != nil {
+			l.metrics.RecordContextEntryErrors(ctx, entryType, policyNamespace, policyName, l.rule, contextEntryErrorCause(ctx, err))
+		}
+	}
+	return err
+}
+
+// contextEntryErrorCause returns the cause of an error returned when loading a context entry
+func contextEntryErrorCause(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return ContextEntryErrorTimeout
+	case apierrors.IsNotFound(err):
+		return ContextEntryErrorNotFound
+	case apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err):
+		return ContextEntryErrorForbidden
+	default:
+		return ContextEntryErrorOther
+	}
+}
+
+// withTimeout returns a deferred loader enforcing the context entry timeout (if any).
+func withTimeout(ctx context.Context, entry kyvernov1.ContextEntry, load func(context.Context) error) enginecontext.DeferredLoader {
+	return func() error {
+		return LoadWithTimeout(ctx, entry, load)
+	}
+}
+
+// LoadWithTimeout runs the loader of a context entry enforcing the entry timeout (if any).
+// When the timeout is exceeded the returned error wraps context.DeadlineExceeded.
+func LoadWithTimeout(ctx context.Context, entry kyvernov1.ContextEntry, load func(context.Context) error) error {
+	if entry.Timeout == nil {
+		return load(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, entry.Timeout.Duration)
+	defer cancel()
+	if err := load(ctx); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("context entry %s exceeded timeout of %s: %w", entry.Name, entry.Timeout.Duration, context.DeadlineExceeded)
+		}
+		return err
+	}
 	return nil
 }
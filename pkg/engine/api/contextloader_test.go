@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type fakeContextLoaderMetrics struct {
+	durations []string
+	errors    []string
+}
+
+func (m *fakeContextLoaderMetrics) RecordContextEntryDuration(_ context.Context, entryType string, policyNamespace string, policyName string, ruleName string, _ float64) {
+	m.durations = append(m.durations, entryType+"/"+policyNamespace+"/"+policyName+"/"+ruleName)
+}
+
+func (m *fakeContextLoaderMetrics) RecordContextEntryErrors(_ context.Context, entryType string, _ string, _ string, _ string, cause string) {
+	m.errors = append(m.errors, entryType+"/"+cause)
+}
+
+func Test_contextLoader_metrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		resolver      ConfigmapResolver
+		wantDurations []string
+		wantErrors    []string
+	}{{
+		name:          "loaded",
+		resolver:      dummyResolver{cm: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}}},
+		wantDurations: []string{"configMap/-/policy/rule"},
+	}, {
+		name:          "not found",
+		resolver:      dummyResolver{err: kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cm")},
+		wantDurations: []string{"configMap/-/policy/rule"},
+		wantErrors:    []string{"configMap/not_found"},
+	}, {
+		name:          "forbidden",
+		resolver:      dummyResolver{err: kerrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "cm", nil)},
+		wantDurations: []string{"configMap/-/policy/rule"},
+		wantErrors:    []string{"configMap/forbidden"},
+	}}
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "policy"}}
+	rule := kyvernov1.Rule{Name: "rule"}
+	entry := kyvernov1.ContextEntry{
+		Name:      "cm",
+		ConfigMap: &kyvernov1.ConfigMapReference{Name: "cm", Namespace: "default"},
+	}
+	jp := jmespath.New(config.NewDefaultConfiguration(false))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &fakeContextLoaderMetrics{}
+			loader := DefaultContextLoaderFactory(tt.resolver, metrics)(policy, rule).(*contextLoader)
+			deferredLoader := loader.newDeferredLoader(context.TODO(), jp, nil, nil, entry, enginecontext.NewContext(jp))
+			err := deferredLoader()
+			assert.Equal(t, len(tt.wantErrors) == 0, err == nil)
+			assert.DeepEqual(t, tt.wantDurations, metrics.durations)
+			assert.DeepEqual(t, tt.wantErrors, metrics.errors)
+		})
+	}
+}
+
+func Test_LoadWithTimeout(t *testing.T) {
+	blocking := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	tests := []struct {
+		name    string
+		timeout *metav1.Duration
+		load    func(context.Context) error
+		wantErr string
+	}{{
+		name: "no timeout",
+		load: func(ctx context.Context) error {
+			_, ok := ctx.Deadline()
+			assert.Assert(t, !ok)
+			return nil
+		},
+	}, {
+		name:    "loaded in time",
+		timeout: &metav1.Duration{Duration: time.Minute},
+		load:    func(context.Context) error { return nil },
+	}, {
+		name:    "error",
+		timeout: &metav1.Duration{Duration: time.Minute},
+		load:    func(context.Context) error { return errors.New("failed") },
+		wantErr: "failed",
+	}, {
+		name:    "timeout exceeded",
+		timeout: &metav1.Duration{Duration: time.Millisecond},
+		load:    blocking,
+		wantErr: "context entry entry exceeded timeout of 1ms: context deadline exceeded",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := kyvernov1.ContextEntry{Name: "entry", Timeout: tt.timeout}
+			err := LoadWithTimeout(context.TODO(), entry, tt.load)
+			if tt.wantErr == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tt.wantErr)
+			}
+		})
+	}
+}
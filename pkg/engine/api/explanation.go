@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ExplanationStepType is the kind of operation recorded in an explanation
+type ExplanationStepType string
+
+const (
+	// ExplanationContext records a context entry being loaded
+	ExplanationContext ExplanationStepType = "Context"
+	// ExplanationPrecondition records a precondition being evaluated
+	ExplanationPrecondition ExplanationStepType = "Precondition"
+	// ExplanationVariable records a variable being substituted
+	ExplanationVariable ExplanationStepType = "Variable"
+	// ExplanationPattern records a pattern mismatch
+	ExplanationPattern ExplanationStepType = "Pattern"
+)
+
+// ExplanationStep is a single operation performed by the engine while processing a rule
+type ExplanationStep struct {
+	// Type is the kind of operation
+	Type ExplanationStepType `json:"type"`
+	// Name identifies the operation (context entry name, condition, variable or pattern path)
+	Name string `json:"name"`
+	// Value is the resolved value (if any)
+	Value interface{} `json:"value,omitempty"`
+	// Result is the outcome of the operation
+	Result string `json:"result,omitempty"`
+	// Error is the error encountered (if any)
+	Error string `json:"error,omitempty"`
+	// Duration is the time taken by the operation
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+func (s ExplanationStep) String() string {
+	out := fmt.Sprintf("%s %s", s.Type, s.Name)
+	if s.Value != nil {
+		out += fmt.Sprintf(" = %v", s.Value)
+	}
+	if s.Result != "" {
+		out += fmt.Sprintf(" -> %s", s.Result)
+	}
+	if s.Error != "" {
+		out += fmt.Sprintf(" (error: %s)", s.Error)
+	}
+	if s.Duration != 0 {
+		out += fmt.Sprintf(" [%s]", s.Duration)
+	}
+	return out
+}
+
+// Explanation records the steps taken by the engine while processing a rule.
+// A nil explanation is valid and records nothing.
+type Explanation struct {
+	lock  sync.Mutex
+	steps []ExplanationStep
+}
+
+// Add records a step, it is safe to call on a nil explanation
+func (e *Explanation) Add(step ExplanationStep) {
+	if e == nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.steps = append(e.steps, step)
+}
+
+// Steps returns the recorded steps
+func (e *Explanation) Steps() []ExplanationStep {
+	if e == nil {
+		return nil
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return append([]ExplanationStep(nil), e.steps...)
+}
+
+type (
+	explainKey     struct{}
+	explanationKey struct{}
+)
+
+// WithExplain returns a context enabling the engine explain mode
+func WithExplain(ctx context.Context) context.Context {
+	return context.WithValue(ctx, explainKey{}, true)
+}
+
+// IsExplainEnabled returns true if the engine explain mode is enabled in the given context
+func IsExplainEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(explainKey{}).(bool)
+	return enabled
+}
+
+// WithExplanation returns a context carrying the explanation of the rule being processed
+func WithExplanation(ctx context.Context, explanation *Explanation) context.Context {
+	return context.WithValue(ctx, explanationKey{}, explanation)
+}
+
+// ExplanationFromContext returns the explanation of the rule being processed, nil if explain mode is not enabled
+func ExplanationFromContext(ctx context.Context) *Explanation {
+	explanation, _ := ctx.Value(explanationKey{}).(*Explanation)
+	return explanation
+}
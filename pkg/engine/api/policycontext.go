@@ -27,4 +27,6 @@ type PolicyContext interface {
 
 	JSONContext() enginecontext.Interface
 	Copy() PolicyContext
+	// Clone returns a copy of the policy context with a cloned JSON context
+	Clone() PolicyContext
 }
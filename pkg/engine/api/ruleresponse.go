@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
@@ -47,6 +49,10 @@ type RuleResponse struct {
 	podSecurityChecks *PodSecurityChecks
 	// exception is the exception applied (if any)
 	exception *kyvernov2alpha1.PolicyException
+	// explanation contains the steps recorded when explain mode is enabled
+	explanation *Explanation
+	// timeout is true if the rule errored because it exceeded a timeout
+	timeout bool
 }
 
 func NewRuleResponse(name string, ruleType RuleType, msg string, status RuleStatus) *RuleResponse {
@@ -60,11 +66,20 @@ func NewRuleResponse(name string, ruleType RuleType, msg string, status RuleStat
 
 func RuleError(name string, ruleType RuleType, msg string, err error) *RuleResponse {
 	if err != nil {
-		return NewRuleResponse(name, ruleType, fmt.Sprintf("%s: %s", msg, err.Error()), RuleStatusError)
+		response := NewRuleResponse(name, ruleType, fmt.Sprintf("%s: %s", msg, err.Error()), RuleStatusError)
+		response.timeout = errors.Is(err, context.DeadlineExceeded)
+		return response
 	}
 	return NewRuleResponse(name, ruleType, msg, RuleStatusError)
 }
 
+// RuleTimeout returns an error response for a rule that exceeded its timeout
+func RuleTimeout(name string, ruleType RuleType, msg string) *RuleResponse {
+	response := NewRuleResponse(name, ruleType, fmt.Sprintf("timeout: %s", msg), RuleStatusError)
+	response.timeout = true
+	return response
+}
+
 func RuleSkip(name string, ruleType RuleType, msg string) *RuleResponse {
 	return NewRuleResponse(name, ruleType, msg, RuleStatusSkip)
 }
@@ -86,6 +101,11 @@ func (r RuleResponse) WithException(exception *kyvernov2alpha1.PolicyException)
 	return &r
 }
 
+func (r RuleResponse) WithExplanation(explanation *Explanation) *RuleResponse {
+	r.explanation = explanation
+	return &r
+}
+
 func (r RuleResponse) WithPodSecurityChecks(checks PodSecurityChecks) *RuleResponse {
 	r.podSecurityChecks = &checks
 	return &r
@@ -125,6 +145,15 @@ func (r *RuleResponse) IsException() bool {
 	return r.exception != nil
 }
 
+// IsTimeout returns true if the rule errored because it exceeded a timeout
+func (r *RuleResponse) IsTimeout() bool {
+	return r.timeout
+}
+
+func (r *RuleResponse) Explanation() *Explanation {
+	return r.explanation
+}
+
 func (r *RuleResponse) PodSecurityChecks() *PodSecurityChecks {
 	return r.podSecurityChecks
 }
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -126,3 +129,41 @@ func TestRuleResponse_HasStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleResponse_IsTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *RuleResponse
+		want     bool
+	}{{
+		name:     "error",
+		response: RuleError("test", Validation, "failed", errors.New("error")),
+		want:     false,
+	}, {
+		name:     "error without cause",
+		response: RuleError("test", Validation, "failed", nil),
+		want:     false,
+	}, {
+		name:     "wrapped deadline exceeded",
+		response: RuleError("test", Validation, "failed", fmt.Errorf("failed to load: %w", context.DeadlineExceeded)),
+		want:     true,
+	}, {
+		name:     "timeout",
+		response: RuleTimeout("test", Validation, "rule exceeded timeout of 1s"),
+		want:     true,
+	}, {
+		name:     "fail",
+		response: RuleFail("test", Validation, "failed"),
+		want:     false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.response.IsTimeout(); got != tt.want {
+				t.Errorf("RuleResponse.IsTimeout() = %v, want %v", got, tt.want)
+			}
+			if tt.want && tt.response.Status() != RuleStatusError {
+				t.Errorf("RuleResponse.Status() = %v, want %v", tt.response.Status(), RuleStatusError)
+			}
+		})
+	}
+}
@@ -76,6 +76,7 @@ func (a *apiCall) execute(ctx context.Context, call *kyvernov1.APICall) ([]byte,
 }
 
 func (a *apiCall) executeK8sAPICall(ctx context.Context, path string, method kyvernov1.Method, data []kyvernov1.RequestData) ([]byte, error) {
+	tracing.SetAttributes(ctx, tracing.ContextEntryURLKey.String(tracing.SanitizeURL(path)))
 	requestData, err := a.buildRequestData(data)
 	if err != nil {
 		return nil, err
@@ -83,7 +84,7 @@ func (a *apiCall) executeK8sAPICall(ctx context.Context, path string, method kyv
 
 	jsonData, err := a.client.RawAbsPath(ctx, path, string(method), requestData)
 	if err != nil {
-		return nil, fmt.Errorf("failed to %v resource with raw url\n: %s: %v", method, path, err)
+		return nil, fmt.Errorf("failed to %v resource with raw url\n: %s: %w", method, path, err)
 	}
 
 	a.logger.V(4).Info("executed APICall", "name", a.entry.Name, "path", path, "method", method, "len", len(jsonData))
@@ -94,6 +95,7 @@ func (a *apiCall) executeServiceCall(ctx context.Context, apiCall *kyvernov1.API
 	if apiCall.Service == nil {
 		return nil, fmt.Errorf("missing service for APICall %s", a.entry.Name)
 	}
+	tracing.SetAttributes(ctx, tracing.ContextEntryURLKey.String(tracing.SanitizeURL(apiCall.Service.URL)))
 
 	client, err := a.buildHTTPClient(apiCall.Service)
 	if err != nil {
@@ -152,7 +154,7 @@ func (a *apiCall) buildHTTPRequest(ctx context.Context, apiCall *kyvernov1.APICa
 			return nil, dataErr
 		}
 
-		req, err = http.NewRequest("POST", apiCall.Service.URL, data)
+		req, err = http.NewRequestWithContext(ctx, "POST", apiCall.Service.URL, data)
 		return
 	}
 
@@ -172,7 +174,9 @@ func (a *apiCall) getToken() string {
 
 func (a *apiCall) buildHTTPClient(service *kyvernov1.ServiceCall) (*http.Client, error) {
 	if service == nil || service.CABundle == "" {
-		return http.DefaultClient, nil
+		return &http.Client{
+			Transport: tracing.Transport(http.DefaultTransport, otelhttp.WithFilter(tracing.RequestFilterIsInSpan)),
+		}, nil
 	}
 	caCertPool := x509.NewCertPool()
 	if ok := caCertPool.AppendCertsFromPEM([]byte(service.CABundle)); !ok {
@@ -94,6 +94,11 @@ type Interface interface {
 	// Reset sets the internal state to the last checkpoint, but does not remove the checkpoint.
 	Reset()
 
+	// Clone creates a new context initialized with a copy of the current internal state and checkpoints,
+	// the clone can be used concurrently with the original context.
+	// Deferred loaders are not copied, see context.Clone.
+	Clone() Interface
+
 	EvalInterface
 
 	// AddJSON  merges the json with context
@@ -353,6 +358,33 @@ func (ctx *context) reset(remove bool) {
 	}
 }
 
+// Clone creates a new context initialized with a copy of the current internal state and checkpoints.
+// Deferred loaders are not copied: a loader writes into the context it was registered with, running it from
+// the clone would mutate the original context concurrently. This is safe because contexts are cloned before
+// processing a policy or a rule, and the rule context entries (the only source of deferred loaders) are
+// registered again on the clone when the rule is processed.
+func (ctx *context) Clone() Interface {
+	ctx.mutex.RLock()
+	defer ctx.mutex.RUnlock()
+	jsonRaw := make([]byte, len(ctx.jsonRaw))
+	copy(jsonRaw, ctx.jsonRaw)
+	jsonRawCheckpoints := make([][]byte, 0, len(ctx.jsonRawCheckpoints))
+	for _, checkpoint := range ctx.jsonRawCheckpoints {
+		jsonRawCheckpoint := make([]byte, len(checkpoint))
+		copy(jsonRawCheckpoint, checkpoint)
+		jsonRawCheckpoints = append(jsonRawCheckpoints, jsonRawCheckpoint)
+	}
+	return &context{
+		jp:                 ctx.jp,
+		jsonRaw:            jsonRaw,
+		jsonRawCheckpoints: jsonRawCheckpoints,
+		images:             ctx.images,
+		deferred: deferredLoaders{
+			loaders: make(map[string]DeferredLoader),
+		},
+	}
+}
+
 func (ctx *context) AddDeferredLoader(name string, loader DeferredLoader) {
 	ctx.deferred.mutex.Lock()
 	defer ctx.deferred.mutex.Unlock()
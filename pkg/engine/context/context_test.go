@@ -123,3 +123,54 @@ func Test_addResourceAndUserContext(t *testing.T) {
 		t.Error("expected result does not match")
 	}
 }
+
+func Test_Clone(t *testing.T) {
+	ctx := NewContext(jp)
+	if err := ctx.AddVariable("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	ctx.Checkpoint()
+	clone := ctx.Clone()
+	// the clone starts with the current state
+	result, err := clone.Query("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual("bar", result) {
+		t.Error("expected result does not match")
+	}
+	// changes are not shared
+	if err := clone.AddVariable("foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.AddVariable("other", "value"); err != nil {
+		t.Fatal(err)
+	}
+	result, err = ctx.Query("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual("bar", result) {
+		t.Error("expected result does not match")
+	}
+	if _, err := clone.Query("other"); err == nil {
+		t.Error("expected an error")
+	}
+	// checkpoints are copied
+	clone.Restore()
+	result, err = clone.Query("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual("bar", result) {
+		t.Error("expected result does not match")
+	}
+	// restoring the clone does not affect the original
+	result, err = ctx.Query("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual("value", result) {
+		t.Error("expected result does not match")
+	}
+}
@@ -0,0 +1,31 @@
+package resolvers
+
+import (
+	"context"
+
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/metrics"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type cacheMetricsResolver struct {
+	inner          engineapi.ConfigmapResolver
+	metricsManager metrics.MetricsConfigManager
+}
+
+// WithCacheMetrics returns a resolver recording the hits and misses of the given cache based resolver
+func WithCacheMetrics(inner engineapi.ConfigmapResolver, metricsManager metrics.MetricsConfigManager) engineapi.ConfigmapResolver {
+	if metricsManager == nil {
+		return inner
+	}
+	return &cacheMetricsResolver{
+		inner:          inner,
+		metricsManager: metricsManager,
+	}
+}
+
+func (r *cacheMetricsResolver) Get(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+	cm, err := r.inner.Get(ctx, namespace, name)
+	r.metricsManager.RecordConfigMapCacheLookups(ctx, namespace, err == nil)
+	return cm, err
+}
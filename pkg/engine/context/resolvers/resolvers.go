@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/tracing"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -23,7 +24,9 @@ func NewInformerBasedResolver(lister corev1listers.ConfigMapLister) (engineapi.C
 }
 
 func (i *informerBasedResolver) Get(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
-	return i.lister.ConfigMaps(namespace).Get(name)
+	cm, err := i.lister.ConfigMaps(namespace).Get(name)
+	tracing.SetAttributes(ctx, tracing.ContextEntryCacheHitKey.Bool(err == nil))
+	return cm, err
 }
 
 type clientBasedResolver struct {
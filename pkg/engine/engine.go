@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -35,6 +36,8 @@ type engine struct {
 	rclient              registryclient.Client
 	contextLoader        engineapi.ContextLoaderFactory
 	exceptionSelector    engineapi.PolicyExceptionSelector
+	// validationWorkers is the maximum number of validate rules processed concurrently
+	validationWorkers int
 	// metrics
 	resultCounter     metric.Int64Counter
 	durationHistogram metric.Float64Histogram
@@ -50,6 +53,7 @@ func NewEngine(
 	rclient registryclient.Client,
 	contextLoader engineapi.ContextLoaderFactory,
 	exceptionSelector engineapi.PolicyExceptionSelector,
+	validationWorkers int,
 ) engineapi.Engine {
 	meter := global.MeterProvider().Meter(metrics.MeterName)
 	resultCounter, err := meter.Int64Counter(
@@ -74,6 +78,7 @@ func NewEngine(
 		rclient:              rclient,
 		contextLoader:        contextLoader,
 		exceptionSelector:    exceptionSelector,
+		validationWorkers:    validationWorkers,
 		resultCounter:        resultCounter,
 		durationHistogram:    durationHistogram,
 	}
@@ -227,7 +232,17 @@ func (e *engine) invokeRuleHandler(
 	rule kyvernov1.Rule,
 	ruleType engineapi.RuleType,
 ) (unstructured.Unstructured, []engineapi.RuleResponse) {
-	return tracing.ChildSpan2(
+	var explanation *engineapi.Explanation
+	if engineapi.IsExplainEnabled(ctx) {
+		explanation = &engineapi.Explanation{}
+		ctx = engineapi.WithExplanation(ctx, explanation)
+	}
+	if rule.Timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rule.Timeout.Duration)
+		defer cancel()
+	}
+	patchedResource, responses := tracing.ChildSpan2(
 		ctx,
 		"pkg/engine",
 		fmt.Sprintf("RULE %s", rule.Name),
@@ -248,7 +263,13 @@ func (e *engine) invokeRuleHandler(
 				}
 				// load rule context
 				contextLoader := e.ContextLoader(policyContext.Policy(), rule)
-				if err := contextLoader(ctx, rule.Context, policyContext.JSONContext()); err != nil {
+				var err error
+				if explanation != nil {
+					err = explainContext(ctx, contextLoader, rule.Context, policyContext.JSONContext(), explanation)
+				} else {
+					err = contextLoader(ctx, rule.Context, policyContext.JSONContext())
+				}
+				if err != nil {
 					if _, ok := err.(gojmespath.NotFoundError); ok {
 						logger.V(3).Info("failed to load context", "reason", err.Error())
 					} else {
@@ -257,7 +278,7 @@ func (e *engine) invokeRuleHandler(
 					return resource, handlers.WithError(rule, ruleType, "failed to load context", err)
 				}
 				// check preconditions
-				preconditionsPassed, msg, err := internal.CheckPreconditions(logger, policyContext.JSONContext(), rule.GetAnyAllConditions())
+				preconditionsPassed, msg, err := internal.CheckPreconditionsWithObserver(logger, policyContext.JSONContext(), rule.GetAnyAllConditions(), explainPreconditions(explanation))
 				if err != nil {
 					return resource, handlers.WithError(rule, ruleType, "failed to evaluate preconditions", err)
 				}
@@ -266,9 +287,26 @@ func (e *engine) invokeRuleHandler(
 					return resource, handlers.WithSkip(rule, ruleType, s)
 				}
 				// process handler
-				return handler.Process(ctx, logger, policyContext, resource, rule, contextLoader)
+				return handler.Process(ctx, logger, explainPolicyContext(policyContext, explanation), resource, rule, contextLoader)
 			}
 			return resource, nil
 		},
+		trace.WithAttributes(
+			tracing.PolicyNameKey.String(policyContext.Policy().GetName()),
+			tracing.PolicyNamespaceKey.String(policyContext.Policy().GetNamespace()),
+			tracing.RuleNameKey.String(rule.Name),
+		),
 	)
+	if rule.Timeout != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		logger.Info("rule exceeded timeout", "timeout", rule.Timeout.Duration)
+		// partial results are discarded, the resource is returned unchanged
+		patchedResource = resource
+		responses = handlers.WithResponses(engineapi.RuleTimeout(rule.Name, ruleType, fmt.Sprintf("rule exceeded timeout of %s", rule.Timeout.Duration)))
+	}
+	if explanation != nil {
+		for i := range responses {
+			responses[i] = *responses[i].WithExplanation(explanation)
+		}
+	}
+	return patchedResource, responses
 }
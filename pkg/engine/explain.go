@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
+	"github.com/kyverno/kyverno/pkg/engine/variables"
+)
+
+// explainContext loads context entries one by one, recording the resolved value and the time taken to load each entry.
+// Entries are normally loaded lazily, querying them forces the deferred loaders to run.
+func explainContext(
+	ctx context.Context,
+	contextLoader engineapi.EngineContextLoader,
+	contextEntries []kyvernov1.ContextEntry,
+	jsonContext enginecontext.Interface,
+	explanation *engineapi.Explanation,
+) error {
+	for _, entry := range contextEntries {
+		start := time.Now()
+		step := engineapi.ExplanationStep{
+			Type: engineapi.ExplanationContext,
+			Name: entry.Name,
+		}
+		err := contextLoader(ctx, []kyvernov1.ContextEntry{entry}, jsonContext)
+		if err == nil {
+			step.Value, err = jsonContext.Query(entry.Name)
+		}
+		step.Duration = time.Since(start)
+		if err != nil {
+			step.Error = err.Error()
+			explanation.Add(step)
+			return err
+		}
+		explanation.Add(step)
+	}
+	return nil
+}
+
+// explainPreconditions returns a condition observer recording the resolved key and value of every precondition
+// evaluated along with the result, nil if explain mode is disabled
+func explainPreconditions(explanation *engineapi.Explanation) variables.ConditionObserver {
+	if explanation == nil {
+		return nil
+	}
+	return func(condition kyvernov1.Condition, key, value interface{}, result bool, err error) {
+		step := engineapi.ExplanationStep{
+			Type:   engineapi.ExplanationPrecondition,
+			Name:   fmt.Sprintf("%v %s %v", condition.GetKey(), condition.Operator, condition.GetValue()),
+			Value:  map[string]interface{}{"key": key, "value": value},
+			Result: strconv.FormatBool(result),
+		}
+		if err != nil {
+			step.Result = ""
+			step.Error = err.Error()
+		}
+		explanation.Add(step)
+	}
+}
+
+// explainPolicyContext wraps the policy context so that every variable resolved while the rule is processed is recorded,
+// the policy context is returned unchanged if explain mode is disabled
+func explainPolicyContext(policyContext engineapi.PolicyContext, explanation *engineapi.Explanation) engineapi.PolicyContext {
+	if explanation == nil {
+		return policyContext
+	}
+	return explainingPolicyContext{
+		PolicyContext: policyContext,
+		explanation:   explanation,
+	}
+}
+
+type explainingPolicyContext struct {
+	engineapi.PolicyContext
+	explanation *engineapi.Explanation
+}
+
+func (c explainingPolicyContext) JSONContext() enginecontext.Interface {
+	return explainingJSONContext{
+		Interface:   c.PolicyContext.JSONContext(),
+		explanation: c.explanation,
+	}
+}
+
+func (c explainingPolicyContext) Copy() engineapi.PolicyContext {
+	return explainPolicyContext(c.PolicyContext.Copy(), c.explanation)
+}
+
+func (c explainingPolicyContext) Clone() engineapi.PolicyContext {
+	return explainPolicyContext(c.PolicyContext.Clone(), c.explanation)
+}
+
+// explainingJSONContext implements variables.VariableObserver to record the variables resolved during substitution
+type explainingJSONContext struct {
+	enginecontext.Interface
+	explanation *engineapi.Explanation
+}
+
+func (c explainingJSONContext) ObserveVariable(variable string, value interface{}, err error) {
+	step := engineapi.ExplanationStep{
+		Type:  engineapi.ExplanationVariable,
+		Name:  variable,
+		Value: value,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	c.explanation.Add(step)
+}
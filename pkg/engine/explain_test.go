@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/kyverno/kyverno/pkg/registryclient"
+	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
+	"gotest.tools/assert"
+)
+
+func Test_Explain(t *testing.T) {
+	rawPolicy := []byte(`{
+		"apiVersion": "kyverno.io/v1",
+		"kind": "ClusterPolicy",
+		"metadata": {
+			"name": "require-team"
+		},
+		"spec": {
+			"rules": [
+				{
+					"name": "check-team",
+					"match": {
+						"resources": {
+							"kinds": ["Pod"]
+						}
+					},
+					"context": [
+						{
+							"name": "expected",
+							"variable": {
+								"value": "platform"
+							}
+						}
+					],
+					"preconditions": {
+						"all": [
+							{
+								"key": "{{ request.object.metadata.name }}",
+								"operator": "Equals",
+								"value": "myapp-pod"
+							}
+						]
+					},
+					"validate": {
+						"pattern": {
+							"metadata": {
+								"labels": {
+									"team": "{{ expected }}"
+								}
+							}
+						}
+					}
+				}
+			]
+		}
+	}`)
+	rawResource := []byte(`{
+		"apiVersion": "v1",
+		"kind": "Pod",
+		"metadata": {
+			"name": "myapp-pod",
+			"labels": {
+				"team": "apps"
+			}
+		},
+		"spec": {
+			"containers": [
+				{
+					"name": "nginx",
+					"image": "nginx"
+				}
+			]
+		}
+	}`)
+	var policy kyvernov1.ClusterPolicy
+	assert.NilError(t, json.Unmarshal(rawPolicy, &policy))
+	resource, err := kubeutils.BytesToUnstructured(rawResource)
+	assert.NilError(t, err)
+
+	// explain mode disabled
+	er := testValidate(context.TODO(), registryclient.NewOrDie(), newPolicyContext(t, *resource, kyvernov1.Create, nil).WithPolicy(&policy), cfg, nil)
+	assert.Equal(t, len(er.PolicyResponse.Rules), 1)
+	assert.Assert(t, er.PolicyResponse.Rules[0].Explanation() == nil)
+
+	// explain mode enabled
+	er = testValidate(engineapi.WithExplain(context.TODO()), registryclient.NewOrDie(), newPolicyContext(t, *resource, kyvernov1.Create, nil).WithPolicy(&policy), cfg, nil)
+	assert.Equal(t, len(er.PolicyResponse.Rules), 1)
+	rule := er.PolicyResponse.Rules[0]
+	assert.Equal(t, rule.Status(), engineapi.RuleStatusFail)
+	steps := rule.Explanation().Steps()
+	assert.Equal(t, len(steps), 4)
+
+	assert.Equal(t, steps[0].Type, engineapi.ExplanationContext)
+	assert.Equal(t, steps[0].Name, "expected")
+	assert.Equal(t, steps[0].Value, "platform")
+
+	assert.Equal(t, steps[1].Type, engineapi.ExplanationPrecondition)
+	assert.DeepEqual(t, steps[1].Value, map[string]interface{}{"key": "myapp-pod", "value": "myapp-pod"})
+	assert.Equal(t, steps[1].Result, "true")
+
+	assert.Equal(t, steps[2].Type, engineapi.ExplanationVariable)
+	assert.Equal(t, steps[2].Name, "expected")
+	assert.Equal(t, steps[2].Value, "platform")
+
+	assert.Equal(t, steps[3].Type, engineapi.ExplanationPattern)
+	assert.Equal(t, steps[3].Name, "pattern /metadata/labels/team/")
+	assert.Equal(t, steps[3].Result, "fail")
+}
@@ -8,9 +8,9 @@ import (
 	"github.com/kyverno/kyverno/pkg/engine/context"
 	"github.com/kyverno/kyverno/pkg/engine/internal"
 	"github.com/kyverno/kyverno/pkg/engine/mutate"
+	"github.com/kyverno/kyverno/pkg/engine/mutate/patch"
 	"github.com/kyverno/kyverno/pkg/engine/variables"
 	"github.com/kyverno/kyverno/pkg/utils/api"
-	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -45,13 +45,14 @@ func ForceMutate(
 		}
 
 		if r.Mutation.ForEachMutation != nil {
-			patchedResource, err = applyForEachMutate(r.Name, r.Mutation.ForEachMutation, patchedResource, logger)
+			patchedResource, err = applyForEachMutate(ctx, r.Name, r.Mutation.ForEachMutation, patchedResource, logger)
 			if err != nil {
 				return patchedResource, err
 			}
 		} else {
 			m := r.Mutation
-			patchedResource, err = applyPatches(r.Name, m.GetPatchStrategicMerge(), m.PatchesJSON6902, patchedResource, logger)
+			patcher := mutate.NewPatcher(m.GetPatchStrategicMerge(), m.PatchesJSON6902, m.GetPatchJSONMerge(), m.Expressions, ctx)
+			patchedResource, err = applyPatches(patcher, patchedResource, logger)
 			if err != nil {
 				return patchedResource, err
 			}
@@ -61,7 +62,7 @@ func ForceMutate(
 	return patchedResource, nil
 }
 
-func applyForEachMutate(name string, foreach []kyvernov1.ForEachMutation, resource unstructured.Unstructured, logger logr.Logger) (patchedResource unstructured.Unstructured, err error) {
+func applyForEachMutate(ctx context.EvalInterface, name string, foreach []kyvernov1.ForEachMutation, resource unstructured.Unstructured, logger logr.Logger) (patchedResource unstructured.Unstructured, err error) {
 	patchedResource = resource
 	for _, fe := range foreach {
 		if fe.ForEachMutation != nil {
@@ -70,10 +71,11 @@ func applyForEachMutate(name string, foreach []kyvernov1.ForEachMutation, resour
 				return patchedResource, fmt.Errorf("failed to deserialize foreach: %w", err)
 			}
 
-			return applyForEachMutate(name, nestedForEach, patchedResource, logger)
+			return applyForEachMutate(ctx, name, nestedForEach, patchedResource, logger)
 		}
 
-		patchedResource, err = applyPatches(name, fe.GetPatchStrategicMerge(), fe.PatchesJSON6902, patchedResource, logger)
+		patcher := mutate.NewPatcher(fe.GetPatchStrategicMerge(), fe.PatchesJSON6902, fe.GetPatchJSONMerge(), fe.Expressions, ctx)
+		patchedResource, err = applyPatches(patcher, patchedResource, logger)
 		if err != nil {
 			return resource, err
 		}
@@ -82,8 +84,7 @@ func applyForEachMutate(name string, foreach []kyvernov1.ForEachMutation, resour
 	return patchedResource, nil
 }
 
-func applyPatches(name string, mergePatch apiextensions.JSON, jsonPatch string, resource unstructured.Unstructured, logger logr.Logger) (unstructured.Unstructured, error) {
-	patcher := mutate.NewPatcher(mergePatch, jsonPatch)
+func applyPatches(patcher patch.Patcher, resource unstructured.Unstructured, logger logr.Logger) (unstructured.Unstructured, error) {
 	resourceBytes, err := resource.MarshalJSON()
 	if err != nil {
 		return resource, err
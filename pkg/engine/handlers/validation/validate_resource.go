@@ -120,7 +120,7 @@ func (v *validator) validate(ctx context.Context) *engineapi.RuleResponse {
 			return engineapi.RuleError(v.rule.Name, engineapi.Validation, "variable substitution failed", err)
 		}
 
-		ruleResponse := v.validateResourceWithRule()
+		ruleResponse := v.validateResourceWithRule(ctx)
 		return ruleResponse
 	}
 
@@ -252,26 +252,28 @@ func (v *validator) getDenyMessage(deny bool, msg string) string {
 	}
 }
 
-func (v *validator) validateResourceWithRule() *engineapi.RuleResponse {
+func (v *validator) validateResourceWithRule(ctx context.Context) *engineapi.RuleResponse {
 	element := v.policyContext.Element()
 	if !engineutils.IsEmptyUnstructured(&element) {
-		return v.validatePatterns(element)
+		return v.validatePatterns(ctx, element)
 	}
 	if engineutils.IsDeleteRequest(v.policyContext) {
 		v.log.V(3).Info("skipping validation on deleted resource")
 		return nil
 	}
-	resp := v.validatePatterns(v.policyContext.NewResource())
+	resp := v.validatePatterns(ctx, v.policyContext.NewResource())
 	return resp
 }
 
 // validatePatterns validate pattern and anyPattern
-func (v *validator) validatePatterns(resource unstructured.Unstructured) *engineapi.RuleResponse {
+func (v *validator) validatePatterns(ctx context.Context, resource unstructured.Unstructured) *engineapi.RuleResponse {
+	explanation := engineapi.ExplanationFromContext(ctx)
 	if v.pattern != nil {
 		if err := validate.MatchPattern(v.log, resource.Object, v.pattern); err != nil {
 			pe, ok := err.(*validate.PatternError)
 			if ok {
 				v.log.V(3).Info("validation error", "path", pe.Path, "error", err.Error())
+				explanation.Add(patternStep("pattern", pe))
 
 				if pe.Skip {
 					return engineapi.RuleSkip(v.rule.Name, engineapi.Validation, pe.Error())
@@ -312,6 +314,7 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *engine
 			if pe, ok := err.(*validate.PatternError); ok {
 				var patternErr error
 				v.log.V(3).Info("validation rule failed", "anyPattern[%d]", idx, "path", pe.Path)
+				explanation.Add(patternStep(fmt.Sprintf("anyPattern[%d]", idx), pe))
 
 				if pe.Skip {
 					patternErr = fmt.Errorf("rule %s[%d] skipped: %s", v.rule.Name, idx, err.Error())
@@ -350,6 +353,19 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *engine
 	return engineapi.RulePass(v.rule.Name, engineapi.Validation, v.rule.Validation.Message)
 }
 
+func patternStep(pattern string, pe *validate.PatternError) engineapi.ExplanationStep {
+	result := "fail"
+	if pe.Skip {
+		result = "skip"
+	}
+	return engineapi.ExplanationStep{
+		Type:   engineapi.ExplanationPattern,
+		Name:   fmt.Sprintf("%s %s", pattern, pe.Path),
+		Result: result,
+		Error:  pe.Error(),
+	}
+}
+
 func deserializeAnyPattern(anyPattern apiextensions.JSON) ([]interface{}, error) {
 	if anyPattern == nil {
 		return nil, nil
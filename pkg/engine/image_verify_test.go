@@ -182,8 +182,9 @@ func testVerifyAndPatchImages(
 		jp,
 		nil,
 		rclient,
-		engineapi.DefaultContextLoaderFactory(cmResolver),
+		engineapi.DefaultContextLoaderFactory(cmResolver, nil),
 		nil,
+		1,
 	)
 	return e.VerifyAndPatchImages(
 		ctx,
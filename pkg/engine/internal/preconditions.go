@@ -11,12 +11,16 @@ import (
 )
 
 func CheckPreconditions(logger logr.Logger, jsonContext enginecontext.Interface, anyAllConditions apiextensions.JSON) (bool, string, error) {
+	return CheckPreconditionsWithObserver(logger, jsonContext, anyAllConditions, nil)
+}
+
+func CheckPreconditionsWithObserver(logger logr.Logger, jsonContext enginecontext.Interface, anyAllConditions apiextensions.JSON, observer variables.ConditionObserver) (bool, string, error) {
 	typeConditions, err := utils.TransformConditions(anyAllConditions)
 	if err != nil {
 		return false, "", fmt.Errorf("failed to parse preconditions: %w", err)
 	}
 
-	return variables.EvaluateConditions(logger, jsonContext, typeConditions)
+	return variables.EvaluateConditionsWithObserver(logger, jsonContext, typeConditions, observer)
 }
 
 func CheckDenyPreconditions(logger logr.Logger, jsonContext enginecontext.Interface, anyAllConditions apiextensions.JSON) (bool, string, error) {
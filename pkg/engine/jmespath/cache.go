@@ -0,0 +1,97 @@
+package jmespath
+
+import (
+	"context"
+	"sync"
+
+	gojmespath "github.com/jmespath/go-jmespath"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// queryCacheSize is the maximum number of compiled queries kept in a cache
+	queryCacheSize = 1000
+	// meterName is the same as metrics.MeterName, the metrics package can't be imported here (import cycle)
+	meterName = "kyverno"
+)
+
+var (
+	cacheLookupsOnce sync.Once
+	cacheLookups     metric.Int64Counter
+)
+
+// queryCache is a bounded, concurrency safe cache of compiled queries.
+// Compiled queries are safe for concurrent use and can be shared.
+type queryCache struct {
+	configuration config.Configuration
+	lock          sync.Mutex
+	// functions are built when the first query is compiled and rebuilt when user defined functions change
+	functions  []FunctionEntry
+	generation uint64
+	queries    *lru.Cache
+}
+
+func newQueryCache(configuration config.Configuration, size int) *queryCache {
+	return &queryCache{
+		configuration: configuration,
+		queries:       lru.New(size),
+	}
+}
+
+func (c *queryCache) get(query string) (*gojmespath.JMESPath, error) {
+	functions, generation := c.getFunctions()
+	if jp, ok := c.queries.Get(query); ok {
+		recordCacheLookup(true)
+		return jp.(*gojmespath.JMESPath), nil
+	}
+	recordCacheLookup(false)
+	jp, err := compile(query, functions)
+	if err != nil {
+		return nil, err
+	}
+	// don't cache a query compiled while user defined functions were changing
+	if generation == customFunctionsGeneration() {
+		c.queries.Add(query, jp)
+	}
+	return jp, nil
+}
+
+// getFunctions returns the functions to register in compiled queries,
+// cached queries are discarded when user defined functions have changed
+func (c *queryCache) getFunctions() ([]FunctionEntry, uint64) {
+	generation := customFunctionsGeneration()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.functions == nil || c.generation != generation {
+		c.functions = GetFunctions(c.configuration)
+		c.generation = generation
+		c.queries.Clear()
+	}
+	return c.functions, generation
+}
+
+func recordCacheLookup(hit bool) {
+	cacheLookupsOnce.Do(func() {
+		meter := global.MeterProvider().Meter(meterName)
+		counter, err := meter.Int64Counter(
+			"kyverno_jmespath_query_cache_lookups",
+			metric.WithDescription("can be used to track the hit rate of the compiled JMESPath queries cache"),
+		)
+		if err != nil {
+			logging.Error(err, "failed to register metric kyverno_jmespath_query_cache_lookups")
+		}
+		cacheLookups = counter
+	})
+	if counter := cacheLookups; counter != nil {
+		result := "miss"
+		if hit {
+			result = "hit"
+		}
+		counter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("cache_result", result)))
+	}
+}
@@ -0,0 +1,50 @@
+package jmespath
+
+import (
+	"sync"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_queryCache(t *testing.T) {
+	cache := newQueryCache(cfg, 2)
+	first, err := cache.get("to_upper(foo)")
+	assert.NilError(t, err)
+	second, err := cache.get("to_upper(foo)")
+	assert.NilError(t, err)
+	assert.Assert(t, first == second)
+	result, err := second.Search(map[string]interface{}{"foo": "bar"})
+	assert.NilError(t, err)
+	assert.Equal(t, result, "BAR")
+	// invalid queries are not cached
+	_, err = cache.get("foo[")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, cache.queries.Len(), 1)
+	// the cache is bounded
+	_, err = cache.get("bar")
+	assert.NilError(t, err)
+	_, err = cache.get("baz")
+	assert.NilError(t, err)
+	assert.Equal(t, cache.queries.Len(), 2)
+	third, err := cache.get("to_upper(foo)")
+	assert.NilError(t, err)
+	assert.Assert(t, first != third)
+}
+
+func Test_queryCacheConcurrency(t *testing.T) {
+	jp := New(cfg)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				result, err := jp.Search("truncate(foo, `2`)", map[string]interface{}{"foo": "bar"})
+				assert.NilError(t, err)
+				assert.Equal(t, result, "ba")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,127 @@
+package jmespath
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	gojmespath "github.com/jmespath/go-jmespath"
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/logging"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// standardFunctions are the functions defined by the JMESPath specification
+var standardFunctions = sets.New(
+	"abs", "avg", "ceil", "contains", "ends_with", "floor", "join", "keys", "length", "map", "max", "max_by", "merge",
+	"min", "min_by", "not_null", "reverse", "sort", "sort_by", "starts_with", "sum", "to_array", "to_number",
+	"to_string", "type", "values",
+)
+
+// customFunctions holds the user defined functions, the generation is incremented every time they change
+// so that compiled queries referencing stale functions can be discarded
+var customFunctions = struct {
+	sync.RWMutex
+	generation uint64
+	functions  []kyvernov2alpha1.JMESPathFunction
+}{}
+
+// SetCustomFunctions replaces the user defined functions.
+// Functions that are not valid or that conflict with a builtin function are ignored.
+func SetCustomFunctions(functions ...kyvernov2alpha1.JMESPathFunction) {
+	builtins := standardFunctions.Clone()
+	for _, function := range getBuiltinFunctions(config.NewDefaultConfiguration(false)) {
+		builtins.Insert(function.Name)
+	}
+	var valid []kyvernov2alpha1.JMESPathFunction
+	for _, function := range functions {
+		if err := validateCustomFunction(function, builtins); err != nil {
+			logging.Error(err, "ignoring invalid JMESPath function", "name", function.Name)
+			continue
+		}
+		valid = append(valid, function)
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].Name < valid[j].Name
+	})
+	customFunctions.Lock()
+	defer customFunctions.Unlock()
+	customFunctions.functions = valid
+	customFunctions.generation++
+}
+
+func customFunctionsGeneration() uint64 {
+	customFunctions.RLock()
+	defer customFunctions.RUnlock()
+	return customFunctions.generation
+}
+
+func validateCustomFunction(function kyvernov2alpha1.JMESPathFunction, builtins sets.Set[string]) error {
+	if errs := function.Validate(); len(errs) != 0 {
+		return errs.ToAggregate()
+	}
+	if builtins.Has(function.FunctionName()) {
+		return fmt.Errorf("function %s conflicts with a builtin function", function.FunctionName())
+	}
+	if _, err := gojmespath.Compile(function.Spec.Expression); err != nil {
+		return fmt.Errorf("failed to parse expression: %w", err)
+	}
+	return nil
+}
+
+// getCustomFunctions converts the user defined functions into function entries.
+// Expressions can only call builtin functions, this prevents recursive calls between user defined functions.
+func getCustomFunctions(builtins []FunctionEntry) []FunctionEntry {
+	customFunctions.RLock()
+	defer customFunctions.RUnlock()
+	var entries []FunctionEntry
+	for _, function := range customFunctions.functions {
+		jp, err := compile(function.Spec.Expression, builtins)
+		if err != nil {
+			logging.Error(err, "failed to compile JMESPath function", "name", function.Name)
+			continue
+		}
+		var arguments []argSpec
+		var names []string
+		for _, argument := range function.Spec.Arguments {
+			argumentType := jpAny
+			if argument.Type != "" {
+				argumentType = jpType(argument.Type)
+			}
+			arguments = append(arguments, argSpec{Types: []jpType{argumentType}})
+			names = append(names, argument.Name)
+		}
+		returnType := jpAny
+		if function.Spec.ReturnType != "" {
+			returnType = jpType(function.Spec.ReturnType)
+		}
+		entries = append(entries, FunctionEntry{
+			FunctionEntry: gojmespath.FunctionEntry{
+				Name:      function.FunctionName(),
+				Arguments: arguments,
+				Handler:   jpCustomFunction(function.FunctionName(), jp, names),
+			},
+			ReturnType: []jpType{returnType},
+			Note:       function.Spec.Description,
+		})
+	}
+	return entries
+}
+
+func jpCustomFunction(name string, jp *gojmespath.JMESPath, names []string) func(arguments []interface{}) (interface{}, error) {
+	return func(arguments []interface{}) (interface{}, error) {
+		if len(arguments) != len(names) {
+			return nil, formatError(argOutOfBoundsError, name, len(arguments), len(names))
+		}
+		data := make(map[string]interface{}, len(names))
+		for i, name := range names {
+			data[name] = arguments[i]
+		}
+		result, err := jp.Search(data)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to evaluate expression: %w", name, err)
+		}
+		return result, nil
+	}
+}
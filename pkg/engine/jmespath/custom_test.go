@@ -0,0 +1,65 @@
+package jmespath
+
+import (
+	"testing"
+
+	kyvernov2alpha1 "github.com/kyverno/kyverno/api/kyverno/v2alpha1"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCustomFunction(name string, expression string, arguments ...kyvernov2alpha1.JMESPathFunctionArgument) kyvernov2alpha1.JMESPathFunction {
+	return kyvernov2alpha1.JMESPathFunction{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kyvernov2alpha1.JMESPathFunctionSpec{
+			Arguments:  arguments,
+			Expression: expression,
+		},
+	}
+}
+
+func Test_CustomFunctions(t *testing.T) {
+	defer SetCustomFunctions()
+	SetCustomFunctions(
+		newCustomFunction("normalize-team", "to_lower(trim(team, ' '))", kyvernov2alpha1.JMESPathFunctionArgument{Name: "team", Type: kyvernov2alpha1.JMESPathString}),
+		newCustomFunction("registry-host", "split(image, '/')[0]", kyvernov2alpha1.JMESPathFunctionArgument{Name: "image", Type: kyvernov2alpha1.JMESPathString}),
+		// conflicts with a builtin function
+		newCustomFunction("to-upper", "value", kyvernov2alpha1.JMESPathFunctionArgument{Name: "value"}),
+		// invalid expression
+		newCustomFunction("invalid", "foo[", kyvernov2alpha1.JMESPathFunctionArgument{Name: "foo"}),
+	)
+	jp := New(cfg)
+	result, err := jp.Search("normalize_team(team)", map[string]interface{}{"team": " Platform "})
+	assert.NilError(t, err)
+	assert.Equal(t, result, "platform")
+	result, err = jp.Search("registry_host('ghcr.io/kyverno/kyverno:latest')", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, result, "ghcr.io")
+	// argument types are checked
+	_, err = jp.Search("normalize_team(`1`)", nil)
+	assert.Assert(t, err != nil)
+	// builtin functions are not overridden
+	result, err = jp.Search("to_upper('foo')", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, result, "FOO")
+	_, err = jp.Search("invalid('foo')", nil)
+	assert.Assert(t, err != nil)
+	// cached queries are discarded when functions change
+	SetCustomFunctions(newCustomFunction("registry-host", "'docker.io'", kyvernov2alpha1.JMESPathFunctionArgument{Name: "image"}))
+	result, err = jp.Search("registry_host('ghcr.io/kyverno/kyverno:latest')", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, result, "docker.io")
+	_, err = jp.Search("normalize_team(team)", map[string]interface{}{"team": "foo"})
+	assert.Assert(t, err != nil)
+}
+
+func Test_CustomFunctionsListed(t *testing.T) {
+	defer SetCustomFunctions()
+	function := newCustomFunction("registry-host", "split(image, '/')[0]", kyvernov2alpha1.JMESPathFunctionArgument{Name: "image", Type: kyvernov2alpha1.JMESPathString})
+	function.Spec.ReturnType = kyvernov2alpha1.JMESPathString
+	function.Spec.Description = "extracts the registry host from an image reference"
+	SetCustomFunctions(function)
+	functions := GetFunctions(cfg)
+	last := functions[len(functions)-1]
+	assert.Equal(t, last.String(), "registry_host(string) string (extracts the registry host from an image reference)")
+}
@@ -2,11 +2,15 @@ package jmespath
 
 import (
 	"bytes"
+	"crypto/md5" //nolint:gosec
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/x509"
 	"encoding/asn1"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -69,10 +73,22 @@ var (
 	objectFromLists        = "object_from_lists"
 	random                 = "random"
 	x509_decode            = "x509_decode"
+	csrDecode              = "csr_decode"
+	pemSplit               = "pem_split"
+	jwtDecode              = "jwt_decode"
+	sha256Hash             = "sha256"
+	sha512Hash             = "sha512"
+	md5Hash                = "md5"
 	imageNormalize         = "image_normalize"
 )
 
+// GetFunctions returns the builtin functions followed by the user defined functions
 func GetFunctions(configuration config.Configuration) []FunctionEntry {
+	builtins := getBuiltinFunctions(configuration)
+	return append(builtins, getCustomFunctions(builtins)...)
+}
+
+func getBuiltinFunctions(configuration config.Configuration) []FunctionEntry {
 	return []FunctionEntry{{
 		FunctionEntry: gojmespath.FunctionEntry{
 			Name: compare,
@@ -447,6 +463,66 @@ func GetFunctions(configuration config.Configuration) []FunctionEntry {
 		},
 		ReturnType: []jpType{jpObject},
 		Note:       "decodes an x.509 certificate to an object. you may also use this in conjunction with `base64_decode` jmespath function to decode a base64-encoded certificate",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: csrDecode,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpCsrDecode,
+		},
+		ReturnType: []jpType{jpObject},
+		Note:       "decodes a PEM encoded x.509 certificate signing request to an object, all public key algorithms are supported (non RSA public keys are returned PEM encoded)",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: pemSplit,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpPemSplit,
+		},
+		ReturnType: []jpType{jpArrayString},
+		Note:       "splits a PEM bundle into its individual PEM blocks, it can be used with `x509_decode` to inspect every certificate of a chain",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: jwtDecode,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpJwtDecode,
+		},
+		ReturnType: []jpType{jpObject},
+		Note:       "decodes the header and payload of a JSON Web Token, the signature is NOT verified",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: sha256Hash,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpSha256,
+		},
+		ReturnType: []jpType{jpString},
+		Note:       "computes the SHA-256 hash of a string, hex encoded",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: sha512Hash,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpSha512,
+		},
+		ReturnType: []jpType{jpString},
+		Note:       "computes the SHA-512 hash of a string, hex encoded",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: md5Hash,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpMd5,
+		},
+		ReturnType: []jpType{jpString},
+		Note:       "computes the MD5 hash of a string, hex encoded. MD5 is not collision resistant and should only be used to compare with existing checksums",
 	}, {
 		FunctionEntry: gojmespath.FunctionEntry{
 			Name: timeToCron,
@@ -555,6 +631,71 @@ func GetFunctions(configuration config.Configuration) []FunctionEntry {
 		},
 		ReturnType: []jpType{jpString},
 		Note:       "normalizes an image reference",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: ipInCidr,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpIPInCidr,
+		},
+		ReturnType: []jpType{jpBool},
+		Note:       "checks if an IP address (first string) is inside a CIDR range (second string), both IPv4 and IPv6 are supported",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: cidrOverlaps,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpCidrOverlaps,
+		},
+		ReturnType: []jpType{jpBool},
+		Note:       "checks if two CIDR ranges have at least one address in common",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: cidrContains,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString}},
+				{Types: []jpType{jpString}},
+			},
+			Handler: jpCidrContains,
+		},
+		ReturnType: []jpType{jpBool},
+		Note:       "checks if a CIDR range (first string) fully contains another CIDR range (second string)",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: quantityCompare,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString, jpNumber}},
+				{Types: []jpType{jpString, jpNumber}},
+			},
+			Handler: jpQuantityCompare,
+		},
+		ReturnType: []jpType{jpNumber},
+		Note:       "compares two resource quantities (e.g. `500m` and `1`, `1Gi` and `1024Mi`), returns -1 if the first is lower, 0 if they are equal and 1 if the first is greater",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: quantityToNumber,
+			Arguments: []argSpec{
+				{Types: []jpType{jpString, jpNumber}},
+			},
+			Handler: jpQuantityToNumber,
+		},
+		ReturnType: []jpType{jpNumber},
+		Note:       "converts a resource quantity to a number (e.g. `500m` is converted to 0.5, `1Ki` to 1024)",
+	}, {
+		FunctionEntry: gojmespath.FunctionEntry{
+			Name: jsonPatchDiff,
+			Arguments: []argSpec{
+				{Types: []jpType{jpAny}},
+				{Types: []jpType{jpAny}},
+			},
+			Handler: jpJSONPatchDiff,
+		},
+		ReturnType: []jpType{jpArray},
+		Note:       "computes the JSON patch (RFC 6902) operations transforming the first value into the second one, operations are sorted by path",
 	}}
 }
 
@@ -1135,6 +1276,116 @@ func jpX509Decode(arguments []interface{}) (interface{}, error) {
 	}
 }
 
+func jpCsrDecode(arguments []interface{}) (interface{}, error) {
+	if input, err := validateArg(csrDecode, arguments, 0, reflect.String); err != nil {
+		return nil, err
+	} else if block, _ := pem.Decode([]byte(input.String())); block == nil {
+		return nil, formatError(genericError, csrDecode, "failed to decode PEM block")
+	} else if block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+		return nil, formatError(genericError, csrDecode, "PEM block does not contain a CERTIFICATE REQUEST")
+	} else if csr, err := x509.ParseCertificateRequest(block.Bytes); err != nil {
+		return nil, formatError(genericError, csrDecode, err)
+	} else {
+		if pk, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+			csr.PublicKey = PublicKey{
+				N: pk.N.String(),
+				E: pk.E,
+			}
+		} else {
+			// other public keys can't be marshalled to json
+			der, err := x509.MarshalPKIXPublicKey(csr.PublicKey)
+			if err != nil {
+				return nil, formatError(genericError, csrDecode, err)
+			}
+			csr.PublicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		}
+		return encode(csr)
+	}
+}
+
+func jpPemSplit(arguments []interface{}) (interface{}, error) {
+	input, err := validateArg(pemSplit, arguments, 0, reflect.String)
+	if err != nil {
+		return nil, err
+	}
+	blocks := []interface{}{}
+	rest := []byte(input.String())
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, string(pem.EncodeToMemory(block)))
+	}
+	if len(blocks) == 0 {
+		return nil, formatError(genericError, pemSplit, "failed to decode PEM block")
+	}
+	return blocks, nil
+}
+
+func jpJwtDecode(arguments []interface{}) (interface{}, error) {
+	decodePart := func(part string) (interface{}, error) {
+		data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+		if err != nil {
+			return nil, err
+		}
+		var out interface{}
+		if err := json.Unmarshal(data, &out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	input, err := validateArg(jwtDecode, arguments, 0, reflect.String)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(strings.TrimSpace(input.String()), ".")
+	if len(parts) != 3 {
+		return nil, formatError(genericError, jwtDecode, "token must contain three parts separated by dots")
+	}
+	header, err := decodePart(parts[0])
+	if err != nil {
+		return nil, formatError(genericError, jwtDecode, fmt.Sprintf("failed to decode header: %s", err))
+	}
+	payload, err := decodePart(parts[1])
+	if err != nil {
+		return nil, formatError(genericError, jwtDecode, fmt.Sprintf("failed to decode payload: %s", err))
+	}
+	return map[string]interface{}{
+		"header":    header,
+		"payload":   payload,
+		"signature": parts[2],
+	}, nil
+}
+
+func jpSha256(arguments []interface{}) (interface{}, error) {
+	if input, err := validateArg(sha256Hash, arguments, 0, reflect.String); err != nil {
+		return nil, err
+	} else {
+		hash := sha256.Sum256([]byte(input.String()))
+		return hex.EncodeToString(hash[:]), nil
+	}
+}
+
+func jpSha512(arguments []interface{}) (interface{}, error) {
+	if input, err := validateArg(sha512Hash, arguments, 0, reflect.String); err != nil {
+		return nil, err
+	} else {
+		hash := sha512.Sum512([]byte(input.String()))
+		return hex.EncodeToString(hash[:]), nil
+	}
+}
+
+func jpMd5(arguments []interface{}) (interface{}, error) {
+	if input, err := validateArg(md5Hash, arguments, 0, reflect.String); err != nil {
+		return nil, err
+	} else {
+		hash := md5.Sum([]byte(input.String())) //nolint:gosec
+		return hex.EncodeToString(hash[:]), nil
+	}
+}
+
 func jpImageNormalize(configuration config.Configuration) gojmespath.JpFunction {
 	return func(arguments []interface{}) (interface{}, error) {
 		if image, err := validateArg(imageNormalize, arguments, 0, reflect.String); err != nil {
@@ -12,17 +12,17 @@ type Interface interface {
 }
 
 type implementation struct {
-	configuration config.Configuration
+	cache *queryCache
 }
 
 func New(configuration config.Configuration) Interface {
 	return implementation{
-		configuration: configuration,
+		cache: newQueryCache(configuration, queryCacheSize),
 	}
 }
 
 func (i implementation) Query(query string) (Query, error) {
-	return newJMESPath(i.configuration, query)
+	return i.cache.get(query)
 }
 
 func (i implementation) Search(query string, data interface{}) (interface{}, error) {
@@ -0,0 +1,44 @@
+package jmespath
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/mattbaird/jsonpatch"
+)
+
+// function names
+var (
+	jsonPatchDiff = "json_patch_diff"
+)
+
+func jpJSONPatchDiff(arguments []interface{}) (interface{}, error) {
+	if len(arguments) != 2 {
+		return nil, formatError(argOutOfBoundsError, jsonPatchDiff, len(arguments), 2)
+	}
+	original, err := json.Marshal(arguments[0])
+	if err != nil {
+		return nil, formatError(genericError, jsonPatchDiff, err)
+	}
+	modified, err := json.Marshal(arguments[1])
+	if err != nil {
+		return nil, formatError(genericError, jsonPatchDiff, err)
+	}
+	patches, err := jsonpatch.CreatePatch(original, modified)
+	if err != nil {
+		return nil, formatError(genericError, jsonPatchDiff, err)
+	}
+	// map keys are not visited in a stable order, sort operations to make the result predictable
+	sort.SliceStable(patches, func(i, j int) bool {
+		return patches[i].Path < patches[j].Path
+	})
+	data, err := json.Marshal(patches)
+	if err != nil {
+		return nil, formatError(genericError, jsonPatchDiff, err)
+	}
+	result := []interface{}{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, formatError(genericError, jsonPatchDiff, err)
+	}
+	return result, nil
+}
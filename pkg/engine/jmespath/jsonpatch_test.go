@@ -0,0 +1,36 @@
+package jmespath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_JSONPatchDiff(t *testing.T) {
+	var data interface{}
+	err := json.Unmarshal([]byte(`{
+		"old": {"metadata": {"name": "foo", "labels": {"app": "foo", "team": "a"}}, "spec": {"replicas": 1}},
+		"new": {"metadata": {"name": "foo", "labels": {"app": "foo", "env": "prod"}}, "spec": {"replicas": 2}}
+	}`), &data)
+	assert.NilError(t, err)
+	query, err := newJMESPath(cfg, "json_patch_diff(old, new)")
+	assert.NilError(t, err)
+	result, err := query.Search(data)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, []interface{}{
+		map[string]interface{}{"op": "add", "path": "/metadata/labels/env", "value": "prod"},
+		map[string]interface{}{"op": "remove", "path": "/metadata/labels/team"},
+		map[string]interface{}{"op": "replace", "path": "/spec/replicas", "value": 2.0},
+	})
+	query, err = newJMESPath(cfg, "json_patch_diff(old, old)")
+	assert.NilError(t, err)
+	result, err = query.Search(data)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, []interface{}{})
+	query, err = newJMESPath(cfg, "json_patch_diff(old, new)[].path")
+	assert.NilError(t, err)
+	result, err = query.Search(data)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, []interface{}{"/metadata/labels/env", "/metadata/labels/team", "/spec/replicas"})
+}
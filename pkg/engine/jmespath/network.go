@@ -0,0 +1,68 @@
+package jmespath
+
+import (
+	"net/netip"
+	"reflect"
+)
+
+// function names
+var (
+	ipInCidr     = "ip_in_cidr"
+	cidrOverlaps = "cidr_overlaps"
+	cidrContains = "cidr_contains"
+)
+
+func getAddrArg(f string, arguments []interface{}, index int) (netip.Addr, error) {
+	arg, err := validateArg(f, arguments, index, reflect.String)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	addr, err := netip.ParseAddr(arg.String())
+	if err != nil {
+		return netip.Addr{}, formatError(genericError, f, err)
+	}
+	return addr.Unmap(), nil
+}
+
+func getPrefixArg(f string, arguments []interface{}, index int) (netip.Prefix, error) {
+	arg, err := validateArg(f, arguments, index, reflect.String)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	prefix, err := netip.ParsePrefix(arg.String())
+	if err != nil {
+		return netip.Prefix{}, formatError(genericError, f, err)
+	}
+	return prefix.Masked(), nil
+}
+
+func jpIPInCidr(arguments []interface{}) (interface{}, error) {
+	if ip, err := getAddrArg(ipInCidr, arguments, 0); err != nil {
+		return nil, err
+	} else if cidr, err := getPrefixArg(ipInCidr, arguments, 1); err != nil {
+		return nil, err
+	} else {
+		return cidr.Contains(ip), nil
+	}
+}
+
+func jpCidrOverlaps(arguments []interface{}) (interface{}, error) {
+	if cidr1, err := getPrefixArg(cidrOverlaps, arguments, 0); err != nil {
+		return nil, err
+	} else if cidr2, err := getPrefixArg(cidrOverlaps, arguments, 1); err != nil {
+		return nil, err
+	} else {
+		return cidr1.Overlaps(cidr2), nil
+	}
+}
+
+func jpCidrContains(arguments []interface{}) (interface{}, error) {
+	if cidr1, err := getPrefixArg(cidrContains, arguments, 0); err != nil {
+		return nil, err
+	} else if cidr2, err := getPrefixArg(cidrContains, arguments, 1); err != nil {
+		return nil, err
+	} else {
+		// the first range contains the second one if it is not more specific and contains its first address
+		return cidr1.Bits() <= cidr2.Bits() && cidr1.Contains(cidr2.Addr()), nil
+	}
+}
@@ -0,0 +1,45 @@
+package jmespath
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_Network(t *testing.T) {
+	testCases := []struct {
+		test           string
+		expectedResult interface{}
+		err            bool
+	}{
+		{test: "ip_in_cidr('10.0.1.2', '10.0.0.0/16')", expectedResult: true},
+		{test: "ip_in_cidr('10.1.1.2', '10.0.0.0/16')", expectedResult: false},
+		{test: "ip_in_cidr('10.0.1.2', '10.0.1.0/16')", expectedResult: true},
+		{test: "ip_in_cidr('::ffff:10.0.1.2', '10.0.0.0/16')", expectedResult: true},
+		{test: "ip_in_cidr('2001:db8::1', '2001:db8::/32')", expectedResult: true},
+		{test: "ip_in_cidr('2001:db9::1', '2001:db8::/32')", expectedResult: false},
+		{test: "ip_in_cidr('10.0.0.1', '2001:db8::/32')", expectedResult: false},
+		{test: "ip_in_cidr('foo', '10.0.0.0/16')", err: true},
+		{test: "ip_in_cidr('10.0.0.1', '10.0.0.0')", err: true},
+		{test: "cidr_overlaps('10.0.0.0/16', '10.0.255.0/24')", expectedResult: true},
+		{test: "cidr_overlaps('10.0.0.0/16', '10.1.0.0/16')", expectedResult: false},
+		{test: "cidr_overlaps('10.0.0.0/8', '2001:db8::/32')", expectedResult: false},
+		{test: "cidr_overlaps('10.0.0.0/16', 'foo')", err: true},
+		{test: "cidr_contains('10.0.0.0/16', '10.0.255.0/24')", expectedResult: true},
+		{test: "cidr_contains('10.0.255.0/24', '10.0.0.0/16')", expectedResult: false},
+		{test: "cidr_contains('10.0.0.0/16', '10.0.0.0/16')", expectedResult: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			query, err := newJMESPath(cfg, tc.test)
+			assert.NilError(t, err)
+			result, err := query.Search("")
+			if tc.err {
+				assert.Assert(t, err != nil)
+			} else {
+				assert.NilError(t, err)
+				assert.Equal(t, result, tc.expectedResult)
+			}
+		})
+	}
+}
@@ -6,11 +6,15 @@ import (
 )
 
 func newJMESPath(configuration config.Configuration, query string) (*gojmespath.JMESPath, error) {
+	return compile(query, GetFunctions(configuration))
+}
+
+func compile(query string, functions []FunctionEntry) (*gojmespath.JMESPath, error) {
 	jp, err := gojmespath.Compile(query)
 	if err != nil {
 		return nil, err
 	}
-	for _, function := range GetFunctions(configuration) {
+	for _, function := range functions {
 		jp.Register(function.FunctionEntry)
 	}
 	return jp, nil
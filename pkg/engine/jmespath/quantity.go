@@ -0,0 +1,52 @@
+package jmespath
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// function names
+var (
+	quantityCompare  = "quantity_compare"
+	quantityToNumber = "quantity_to_number"
+)
+
+// getQuantityArg parses a quantity argument, numbers are accepted and converted to quantities
+func getQuantityArg(f string, arguments []interface{}, index int) (resource.Quantity, error) {
+	if index >= len(arguments) {
+		return resource.Quantity{}, formatError(argOutOfBoundsError, f, index+1, len(arguments))
+	}
+	var value string
+	switch arg := arguments[index].(type) {
+	case string:
+		value = arg
+	case float64:
+		value = strconv.FormatFloat(arg, 'f', -1, 64)
+	default:
+		return resource.Quantity{}, formatError(invalidArgumentTypeError, f, index+1, "string or number")
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}, formatError(genericError, f, err)
+	}
+	return q, nil
+}
+
+func jpQuantityCompare(arguments []interface{}) (interface{}, error) {
+	if q1, err := getQuantityArg(quantityCompare, arguments, 0); err != nil {
+		return nil, err
+	} else if q2, err := getQuantityArg(quantityCompare, arguments, 1); err != nil {
+		return nil, err
+	} else {
+		return float64(q1.Cmp(q2)), nil
+	}
+}
+
+func jpQuantityToNumber(arguments []interface{}) (interface{}, error) {
+	if q, err := getQuantityArg(quantityToNumber, arguments, 0); err != nil {
+		return nil, err
+	} else {
+		return q.AsApproximateFloat64(), nil
+	}
+}
@@ -0,0 +1,45 @@
+package jmespath
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_Quantity(t *testing.T) {
+	testCases := []struct {
+		test           string
+		expectedResult interface{}
+		err            bool
+	}{
+		{test: "quantity_compare('500m', '1')", expectedResult: -1.0},
+		{test: "quantity_compare('1', '500m')", expectedResult: 1.0},
+		{test: "quantity_compare('1Gi', '1024Mi')", expectedResult: 0.0},
+		{test: "quantity_compare('1G', '1Gi')", expectedResult: -1.0},
+		{test: "quantity_compare('1500m', `1.5`)", expectedResult: 0.0},
+		{test: "quantity_compare(`2`, `1`)", expectedResult: 1.0},
+		{test: "quantity_compare('foo', '1')", err: true},
+		{test: "quantity_compare('1Gi', '1024Mi') == `0`", expectedResult: true},
+		{test: "quantity_compare('500m', '1') == `-1`", expectedResult: true},
+		{test: "quantity_compare('2', '1') > `0`", expectedResult: true},
+		{test: "quantity_compare('2', '1') < `0`", expectedResult: false},
+		{test: "quantity_to_number('1Ki') == `1024`", expectedResult: true},
+		{test: "quantity_to_number('500m')", expectedResult: 0.5},
+		{test: "quantity_to_number('1Ki')", expectedResult: 1024.0},
+		{test: "quantity_to_number(`3`)", expectedResult: 3.0},
+		{test: "quantity_to_number('1x')", err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			query, err := newJMESPath(cfg, tc.test)
+			assert.NilError(t, err)
+			result, err := query.Search("")
+			if tc.err {
+				assert.Assert(t, err != nil)
+			} else {
+				assert.NilError(t, err)
+				assert.Equal(t, result, tc.expectedResult)
+			}
+		})
+	}
+}
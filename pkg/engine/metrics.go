@@ -48,6 +48,9 @@ func (e *engine) reportMetrics(
 				ruleResult = metrics.Warn
 			case engineapi.RuleStatusError:
 				ruleResult = metrics.Error
+				if rule.IsTimeout() {
+					ruleResult = metrics.Timeout
+				}
 			case engineapi.RuleStatusSkip:
 				ruleResult = metrics.Skip
 			default:
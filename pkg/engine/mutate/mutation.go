@@ -45,7 +45,7 @@ func Mutate(rule *kyvernov1.Rule, ctx context.Interface, resource unstructured.U
 		return NewErrorResponse("variable substitution failed", err)
 	}
 	m := updatedRule.Mutation
-	patcher := NewPatcher(m.GetPatchStrategicMerge(), m.PatchesJSON6902)
+	patcher := NewPatcher(m.GetPatchStrategicMerge(), m.PatchesJSON6902, m.GetPatchJSONMerge(), m.Expressions, ctx)
 	if patcher == nil {
 		return NewErrorResponse("empty mutate rule", nil)
 	}
@@ -81,7 +81,7 @@ func ForEach(name string, foreach kyvernov1.ForEachMutation, policyContext engin
 	if err != nil {
 		return NewErrorResponse("variable substitution failed", err)
 	}
-	patcher := NewPatcher(fe.GetPatchStrategicMerge(), fe.PatchesJSON6902)
+	patcher := NewPatcher(fe.GetPatchStrategicMerge(), fe.PatchesJSON6902, fe.GetPatchJSONMerge(), fe.Expressions, ctx)
 	if patcher == nil {
 		return NewErrorResponse("empty mutate rule", nil)
 	}
@@ -129,12 +129,24 @@ func substituteAllInForEach(fe kyvernov1.ForEachMutation, ctx context.Interface,
 	return &updatedForEach, nil
 }
 
-func NewPatcher(strategicMergePatch apiextensions.JSON, jsonPatch string) patch.Patcher {
+func NewPatcher(
+	strategicMergePatch apiextensions.JSON,
+	jsonPatch string,
+	jsonMergePatch apiextensions.JSON,
+	expressions []kyvernov1.MutationExpression,
+	jsonContext context.EvalInterface,
+) patch.Patcher {
 	if strategicMergePatch != nil {
 		return patch.NewPatchStrategicMerge(strategicMergePatch)
 	}
 	if len(jsonPatch) > 0 {
 		return patch.NewPatchesJSON6902(jsonPatch)
 	}
+	if jsonMergePatch != nil {
+		return patch.NewPatchJSONMerge(jsonMergePatch)
+	}
+	if len(expressions) > 0 {
+		return patch.NewExpressions(expressions, jsonContext)
+	}
 	return nil
 }
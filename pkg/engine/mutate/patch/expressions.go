@@ -0,0 +1,178 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/cel-go/cel"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// celCostLimit bounds the runtime cost of a mutation expression, it matches the per expression
+	// limit applied by Kubernetes to validating admission policies
+	celCostLimit = 1000000
+	// celProgramsCacheSize is the number of compiled expressions kept in memory
+	celProgramsCacheSize = 1000
+	// celProgramsCacheTTL is the duration a compiled expression is kept in memory
+	celProgramsCacheTTL = time.Hour
+)
+
+var (
+	celEnv      *cel.Env
+	celEnvErr   error
+	celEnvOnce  sync.Once
+	celPrograms = cache.NewLRUExpireCache(celProgramsCacheSize)
+)
+
+func getCELEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("object", cel.DynType),
+			cel.Variable("element", cel.DynType),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+// CompileCEL compiles a CEL mutation expression, the resource is available as `object`
+// and the current foreach element as `element`.
+// Compiled programs are cached and their evaluation cost is limited.
+func CompileCEL(expression string) (cel.Program, error) {
+	if program, ok := celPrograms.Get(expression); ok {
+		return program.(cel.Program), nil
+	}
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return nil, err
+	}
+	celPrograms.Add(expression, program, celProgramsCacheTTL)
+	return program, nil
+}
+
+// ProcessExpressions evaluates the expressions and sets the results at the corresponding paths.
+// Expressions are applied in order, each expression sees the resource patched by the previous ones.
+func ProcessExpressions(logger logr.Logger, expressions []kyvernov1.MutationExpression, jsonContext enginecontext.EvalInterface, resource resource) (resource, patches, error) {
+	patchedResourceRaw := resource
+	for _, expression := range expressions {
+		value, err := evaluateExpression(expression, jsonContext, patchedResourceRaw)
+		if err != nil {
+			logger.Error(err, "failed to evaluate mutation expression", "path", expression.Path)
+			return nil, nil, fmt.Errorf("failed to evaluate expression for path %s: %w", expression.Path, err)
+		}
+		patchedResourceRaw, err = setValue(patchedResourceRaw, expression.Path, value)
+		if err != nil {
+			logger.Error(err, "failed to apply mutation expression", "path", expression.Path)
+			return nil, nil, fmt.Errorf("failed to set path %s: %w", expression.Path, err)
+		}
+	}
+	patchesBytes, err := generatePatches(resource, patchedResourceRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return patchedResourceRaw, patchesBytes, nil
+}
+
+func evaluateExpression(expression kyvernov1.MutationExpression, jsonContext enginecontext.EvalInterface, resource resource) (interface{}, error) {
+	if expression.JMESPath != "" {
+		return jsonContext.Query(expression.JMESPath)
+	}
+	if expression.CEL == "" {
+		return nil, errors.New("one of `jmesPath` or `cel` is required")
+	}
+	program, err := CompileCEL(expression.CEL)
+	if err != nil {
+		return nil, err
+	}
+	object, err := decodeObject(resource)
+	if err != nil {
+		return nil, err
+	}
+	// element is only defined in foreach declarations
+	element, err := jsonContext.Query("element")
+	if err != nil {
+		element = nil
+	}
+	result, _, err := program.Eval(map[string]interface{}{
+		"object":  object,
+		"element": element,
+	})
+	if err != nil {
+		return nil, err
+	}
+	native, err := result.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, err
+	}
+	return native.(*structpb.Value).AsInterface(), nil
+}
+
+// decodeObject decodes the resource, integers are decoded as int64 so that CEL integer arithmetic works as expected
+func decodeObject(resource resource) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(resource))
+	decoder.UseNumber()
+	var object interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return convertNumbers(object), nil
+}
+
+func convertNumbers(in interface{}) interface{} {
+	switch typed := in.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			typed[key] = convertNumbers(value)
+		}
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = convertNumbers(value)
+		}
+	case json.Number:
+		if i, err := typed.Int64(); err == nil {
+			return i
+		}
+		if f, err := typed.Float64(); err == nil {
+			return f
+		}
+	}
+	return in
+}
+
+// setValue replaces the value at path, or adds it (creating missing parents) if the path doesn't exist
+func setValue(resource resource, path string, value interface{}) (resource, error) {
+	op := map[string]interface{}{
+		"op":    "replace",
+		"path":  path,
+		"value": value,
+	}
+	patch, err := json.Marshal([]interface{}{op})
+	if err != nil {
+		return nil, err
+	}
+	if patched, err := applyPatchesWithOptions(resource, patch); err == nil {
+		return patched, nil
+	}
+	op["op"] = "add"
+	if patch, err = json.Marshal([]interface{}{op}); err != nil {
+		return nil, err
+	}
+	return applyPatchesWithOptions(resource, patch)
+}
@@ -0,0 +1,103 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
+	"github.com/kyverno/kyverno/pkg/engine/jmespath"
+	assert "github.com/stretchr/testify/assert"
+)
+
+func TestProcessExpressions(t *testing.T) {
+	resource := []byte(`{"metadata":{"name":"test","labels":{"app":"nginx"}},"spec":{"replicas":1}}`)
+	jsonContext := enginecontext.NewContext(jmespath.New(config.NewDefaultConfiguration(false)))
+	assert.Nil(t, jsonContext.AddContextEntry("team", []byte(`"platform"`)))
+	tests := []struct {
+		name        string
+		expressions []kyvernov1.MutationExpression
+		expected    string
+		wantErr     bool
+	}{{
+		name: "jmespath",
+		expressions: []kyvernov1.MutationExpression{{
+			Path:     "/metadata/labels/team",
+			JMESPath: "team",
+		}},
+		expected: `{"metadata":{"name":"test","labels":{"app":"nginx","team":"platform"}},"spec":{"replicas":1}}`,
+	}, {
+		name: "cel replaces existing value",
+		expressions: []kyvernov1.MutationExpression{{
+			Path: "/spec/replicas",
+			CEL:  "object.spec.replicas + 2",
+		}},
+		expected: `{"metadata":{"name":"test","labels":{"app":"nginx"}},"spec":{"replicas":3}}`,
+	}, {
+		name: "cel creates missing parents",
+		expressions: []kyvernov1.MutationExpression{{
+			Path: "/metadata/annotations/owner",
+			CEL:  "object.metadata.name + '-owner'",
+		}},
+		expected: `{"metadata":{"name":"test","labels":{"app":"nginx"},"annotations":{"owner":"test-owner"}},"spec":{"replicas":1}}`,
+	}, {
+		name: "expressions are chained",
+		expressions: []kyvernov1.MutationExpression{{
+			Path: "/spec/replicas",
+			CEL:  "5",
+		}, {
+			Path: "/metadata/labels/replicas",
+			CEL:  "string(object.spec.replicas)",
+		}},
+		expected: `{"metadata":{"name":"test","labels":{"app":"nginx","replicas":"5"}},"spec":{"replicas":5}}`,
+	}, {
+		name: "invalid cel",
+		expressions: []kyvernov1.MutationExpression{{
+			Path: "/spec/replicas",
+			CEL:  "object.spec.replicas +",
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patched, patches, err := ProcessExpressions(logr.Discard(), tt.expressions, jsonContext, resource)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.NotEmpty(t, patches)
+			assert.JSONEq(t, tt.expected, string(patched))
+		})
+	}
+}
+
+func TestCompileCEL(t *testing.T) {
+	_, err := CompileCEL("element.name == object.metadata.name")
+	assert.Nil(t, err)
+	_, err = CompileCEL("unknown.name")
+	assert.NotNil(t, err)
+}
+
+func TestCompileCEL_cache(t *testing.T) {
+	first, err := CompileCEL("object.metadata.name")
+	assert.Nil(t, err)
+	second, err := CompileCEL("object.metadata.name")
+	assert.Nil(t, err)
+	assert.Same(t, first, second)
+}
+
+func TestCompileCEL_costLimit(t *testing.T) {
+	program, err := CompileCEL("object.items.map(a, object.items.map(b, object.items.map(c, a + b + c)))")
+	assert.Nil(t, err)
+	items := make([]interface{}, 200)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	_, _, err = program.Eval(map[string]interface{}{
+		"object":  map[string]interface{}{"items": items},
+		"element": nil,
+	})
+	assert.ErrorContains(t, err, "cost limit exceeded")
+}
@@ -0,0 +1,28 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/go-logr/logr"
+)
+
+// ProcessPatchJSONMerge applies a RFC 7386 JSON merge patch to the resource
+func ProcessPatchJSONMerge(logger logr.Logger, mergePatch interface{}, resource resource) (resource, patches, error) {
+	patchBytes, err := json.Marshal(mergePatch)
+	if err != nil {
+		logger.Error(err, "failed to marshal JSON merge patch")
+		return nil, nil, err
+	}
+	patchedResourceRaw, err := jsonpatch.MergePatch(resource, patchBytes)
+	if err != nil {
+		logger.Error(err, "failed to apply JSON merge patch")
+		return nil, nil, fmt.Errorf("failed to apply JSON merge patch: %w", err)
+	}
+	patchesBytes, err := generatePatches(resource, patchedResourceRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return patchedResourceRaw, patchesBytes, nil
+}
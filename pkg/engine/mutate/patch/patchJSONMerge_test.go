@@ -0,0 +1,44 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	assert "github.com/stretchr/testify/assert"
+)
+
+func TestProcessPatchJSONMerge(t *testing.T) {
+	resource := []byte(`{"metadata":{"name":"test","labels":{"app":"nginx","tier":"web"}},"spec":{"ports":[{"port":80},{"port":443}]}}`)
+	mergePatch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				"tier": nil,
+				"team": "platform",
+			},
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{
+				map[string]interface{}{"port": 8080},
+			},
+		},
+	}
+	patched, patches, err := ProcessPatchJSONMerge(logr.Discard(), mergePatch, resource)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, patches)
+	// lists are replaced and null values remove fields
+	assert.JSONEq(t, `{"metadata":{"name":"test","labels":{"app":"nginx","team":"platform"}},"spec":{"ports":[{"port":8080}]}}`, string(patched))
+}
+
+func TestProcessPatchJSONMerge_NoChange(t *testing.T) {
+	resource := []byte(`{"metadata":{"name":"test","labels":{"app":"nginx"}}}`)
+	mergePatch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				"app": "nginx",
+			},
+		},
+	}
+	_, patches, err := ProcessPatchJSONMerge(logr.Discard(), mergePatch, resource)
+	assert.Nil(t, err)
+	assert.Empty(t, patches)
+}
@@ -2,6 +2,8 @@ package patch
 
 import (
 	"github.com/go-logr/logr"
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	enginecontext "github.com/kyverno/kyverno/pkg/engine/context"
 	"github.com/mattbaird/jsonpatch"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 )
@@ -50,3 +52,35 @@ func (h patchesJSON6902Handler) Patch(logger logr.Logger, resource resource) (re
 	}
 	return ProcessPatchJSON6902(logger, patchesJSON6902, resource)
 }
+
+// patchJSONMergeHandler
+type patchJSONMergeHandler struct {
+	patch apiextensions.JSON
+}
+
+func NewPatchJSONMerge(patch apiextensions.JSON) Patcher {
+	return patchJSONMergeHandler{
+		patch: patch,
+	}
+}
+
+func (h patchJSONMergeHandler) Patch(logger logr.Logger, resource resource) (resource, patches, error) {
+	return ProcessPatchJSONMerge(logger, h.patch, resource)
+}
+
+// expressionsHandler
+type expressionsHandler struct {
+	expressions []kyvernov1.MutationExpression
+	jsonContext enginecontext.EvalInterface
+}
+
+func NewExpressions(expressions []kyvernov1.MutationExpression, jsonContext enginecontext.EvalInterface) Patcher {
+	return expressionsHandler{
+		expressions: expressions,
+		jsonContext: jsonContext,
+	}
+}
+
+func (h expressionsHandler) Patch(logger logr.Logger, resource resource) (resource, patches, error) {
+	return ProcessExpressions(logger, h.expressions, h.jsonContext, resource)
+}
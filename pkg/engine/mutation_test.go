@@ -28,7 +28,7 @@ func testMutate(
 	contextLoader engineapi.ContextLoaderFactory,
 ) engineapi.EngineResponse {
 	if contextLoader == nil {
-		contextLoader = engineapi.DefaultContextLoaderFactory(nil)
+		contextLoader = engineapi.DefaultContextLoaderFactory(nil, nil)
 	}
 	e := NewEngine(
 		cfg,
@@ -38,6 +38,7 @@ func testMutate(
 		rclient,
 		contextLoader,
 		nil,
+		1,
 	)
 	return e.Mutate(
 		ctx,
@@ -117,6 +117,12 @@ func (c PolicyContext) Copy() engineapi.PolicyContext {
 	return c.copy()
 }
 
+func (c PolicyContext) Clone() engineapi.PolicyContext {
+	copy := c.copy()
+	copy.jsonContext = c.jsonContext.Clone()
+	return copy
+}
+
 // Mutators
 
 func (c *PolicyContext) WithPolicy(policy kyvernov1.PolicyInterface) *PolicyContext {
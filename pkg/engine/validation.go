@@ -2,6 +2,8 @@ package engine
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -11,6 +13,8 @@ import (
 	"github.com/kyverno/kyverno/pkg/engine/handlers"
 	"github.com/kyverno/kyverno/pkg/engine/handlers/validation"
 	"github.com/kyverno/kyverno/pkg/engine/internal"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func (e *engine) validate(
@@ -26,47 +30,14 @@ func (e *engine) validate(
 	policyContext.JSONContext().Checkpoint()
 	defer policyContext.JSONContext().Restore()
 
-	for _, rule := range autogen.ComputeRules(policy) {
+	rules := autogen.ComputeRules(policy)
+	// rules are independent unless only one rule should be applied
+	if e.validationWorkers > 1 && applyRules != kyvernov1.ApplyOne && len(rules) > 1 {
+		return e.validateConcurrently(ctx, logger, policyContext, rules)
+	}
+	for _, rule := range rules {
 		startTime := time.Now()
-		logger := internal.LoggerWithRule(logger, rule)
-		handlerFactory := func() (handlers.Handler, error) {
-			hasValidate := rule.HasValidate()
-			hasVerifyImageChecks := rule.HasVerifyImageChecks()
-			if !hasValidate && !hasVerifyImageChecks {
-				return nil, nil
-			}
-			if hasValidate {
-				hasVerifyManifest := rule.HasVerifyManifests()
-				hasValidatePss := rule.HasValidatePodSecurity()
-				if hasVerifyManifest {
-					return validation.NewValidateManifestHandler(
-						policyContext,
-						e.client,
-					)
-				} else if hasValidatePss {
-					return validation.NewValidatePssHandler()
-				} else {
-					return validation.NewValidateResourceHandler()
-				}
-			} else if hasVerifyImageChecks {
-				return validation.NewValidateImageHandler(
-					policyContext,
-					policyContext.NewResource(),
-					rule,
-					e.configuration,
-				)
-			}
-			return nil, nil
-		}
-		resource, ruleResp := e.invokeRuleHandler(
-			ctx,
-			logger,
-			handlerFactory,
-			policyContext,
-			matchedResource,
-			rule,
-			engineapi.Validation,
-		)
+		resource, ruleResp := e.validateRule(ctx, logger, policyContext, matchedResource, rule)
 		matchedResource = resource
 		resp.Add(engineapi.NewExecutionStats(startTime, time.Now()), ruleResp...)
 		if applyRules == kyvernov1.ApplyOne && resp.RulesAppliedCount() > 0 {
@@ -75,3 +46,98 @@ func (e *engine) validate(
 	}
 	return resp
 }
+
+// validateConcurrently processes rules using a bounded pool of workers, every rule is processed
+// with its own copy of the JSON context and responses are added in the order the rules are declared.
+func (e *engine) validateConcurrently(
+	ctx context.Context,
+	logger logr.Logger,
+	policyContext engineapi.PolicyContext,
+	rules []kyvernov1.Rule,
+) engineapi.PolicyResponse {
+	type result struct {
+		stats     engineapi.ExecutionStats
+		responses []engineapi.RuleResponse
+	}
+	results := make([]result, len(rules))
+	var group errgroup.Group
+	group.SetLimit(e.validationWorkers)
+	for i := range rules {
+		i := i
+		rulePolicyContext := policyContext.Clone()
+		group.Go(func() error {
+			startTime := time.Now()
+			// a panic in a worker would crash the process, it is reported as a rule error instead
+			defer func() {
+				if r := recover(); r != nil {
+					err := fmt.Errorf("panic: %v", r)
+					logger.Error(err, "rule processing panicked", "rule", rules[i].Name, "stack", string(debug.Stack()))
+					results[i] = result{
+						stats:     engineapi.NewExecutionStats(startTime, time.Now()),
+						responses: handlers.WithError(rules[i], engineapi.Validation, "failed to process rule", err),
+					}
+				}
+			}()
+			_, ruleResp := e.validateRule(ctx, logger, rulePolicyContext, rulePolicyContext.NewResource(), rules[i])
+			results[i] = result{
+				stats:     engineapi.NewExecutionStats(startTime, time.Now()),
+				responses: ruleResp,
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+	resp := engineapi.NewPolicyResponse()
+	for _, result := range results {
+		resp.Add(result.stats, result.responses...)
+	}
+	return resp
+}
+
+func (e *engine) validateRule(
+	ctx context.Context,
+	logger logr.Logger,
+	policyContext engineapi.PolicyContext,
+	resource unstructured.Unstructured,
+	rule kyvernov1.Rule,
+) (unstructured.Unstructured, []engineapi.RuleResponse) {
+	logger = internal.LoggerWithRule(logger, rule)
+	handlerFactory := func() (handlers.Handler, error) {
+		hasValidate := rule.HasValidate()
+		hasVerifyImageChecks := rule.HasVerifyImageChecks()
+		if !hasValidate && !hasVerifyImageChecks {
+			return nil, nil
+		}
+		if hasValidate {
+			hasVerifyManifest := rule.HasVerifyManifests()
+			hasValidatePss := rule.HasValidatePodSecurity()
+			if hasVerifyManifest {
+				return validation.NewValidateManifestHandler(
+					policyContext,
+					e.client,
+				)
+			} else if hasValidatePss {
+				return validation.NewValidatePssHandler()
+			} else {
+				return validation.NewValidateResourceHandler()
+			}
+		} else if hasVerifyImageChecks {
+			return validation.NewValidateImageHandler(
+				policyContext,
+				policyContext.NewResource(),
+				rule,
+				e.configuration,
+			)
+		}
+		return nil, nil
+	}
+	return e.invokeRuleHandler(
+		ctx,
+		logger,
+		handlerFactory,
+		policyContext,
+		resource,
+		rule,
+		engineapi.Validation,
+	)
+}
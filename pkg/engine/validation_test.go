@@ -3,8 +3,11 @@ package engine
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	kyverno "github.com/kyverno/kyverno/api/kyverno/v1"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
@@ -29,7 +32,7 @@ func testValidate(
 	contextLoader engineapi.ContextLoaderFactory,
 ) engineapi.EngineResponse {
 	if contextLoader == nil {
-		contextLoader = engineapi.DefaultContextLoaderFactory(nil)
+		contextLoader = engineapi.DefaultContextLoaderFactory(nil, nil)
 	}
 	e := NewEngine(
 		cfg,
@@ -39,6 +42,7 @@ func testValidate(
 		rclient,
 		contextLoader,
 		nil,
+		1,
 	)
 	return e.Validate(
 		ctx,
@@ -3230,3 +3234,119 @@ func Test_ValidatePattern_anyPattern(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateConcurrently(t *testing.T) {
+	rawPolicy := []byte(`{
+		"apiVersion": "kyverno.io/v1",
+		"kind": "ClusterPolicy",
+		"metadata": {"name": "concurrent"},
+		"spec": {
+			"validationFailureAction": "enforce",
+			"rules": [
+				{"name": "rule-1", "match": {"resources": {"kinds": ["Pod"]}}, "context": [{"name": "expected", "variable": {"value": "a"}}], "validate": {"message": "label must be {{ expected }}", "pattern": {"metadata": {"labels": {"first": "{{ expected }}"}}}}},
+				{"name": "rule-2", "match": {"resources": {"kinds": ["Pod"]}}, "context": [{"name": "expected", "variable": {"value": "b"}}], "validate": {"message": "label must be {{ expected }}", "pattern": {"metadata": {"labels": {"second": "{{ expected }}"}}}}},
+				{"name": "rule-3", "match": {"resources": {"kinds": ["Service"]}}, "validate": {"message": "never applied", "pattern": {"metadata": {"name": "?*"}}}},
+				{"name": "rule-4", "match": {"resources": {"kinds": ["Pod"]}}, "context": [{"name": "expected", "variable": {"value": "c"}}], "validate": {"message": "label must be {{ expected }}", "pattern": {"metadata": {"labels": {"third": "{{ expected }}"}}}}},
+				{"name": "rule-5", "match": {"resources": {"kinds": ["Pod"]}}, "preconditions": {"all": [{"key": "{{ request.object.metadata.name }}", "operator": "Equals", "value": "other"}]}, "validate": {"message": "skipped", "pattern": {"metadata": {"name": "other"}}}}
+			]
+		}
+	}`)
+	rawResource := []byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test", "labels": {"first": "a", "second": "x", "third": "c"}}, "spec": {"containers": [{"name": "nginx", "image": "nginx"}]}}`)
+	var policy kyverno.ClusterPolicy
+	assert.NilError(t, json.Unmarshal(rawPolicy, &policy))
+	resource, err := kubeutils.BytesToUnstructured(rawResource)
+	assert.NilError(t, err)
+	validate := func(workers int) engineapi.EngineResponse {
+		e := NewEngine(
+			cfg,
+			config.NewDefaultMetricsConfiguration(),
+			jp,
+			nil,
+			registryclient.NewOrDie(),
+			engineapi.DefaultContextLoaderFactory(nil, nil),
+			nil,
+			workers,
+		)
+		return e.Validate(context.TODO(), newPolicyContext(t, *resource, kyverno.Create, nil).WithPolicy(&policy))
+	}
+	sequential := validate(1)
+	concurrent := validate(4)
+	type result struct {
+		Name    string
+		Status  engineapi.RuleStatus
+		Message string
+	}
+	results := func(response engineapi.EngineResponse) []result {
+		var out []result
+		for _, rule := range response.PolicyResponse.Rules {
+			out = append(out, result{rule.Name(), rule.Status(), rule.Message()})
+		}
+		return out
+	}
+	expected := []result{
+		{"rule-1", engineapi.RuleStatusPass, "validation rule 'rule-1' passed."},
+		{"rule-2", engineapi.RuleStatusFail, "validation error: label must be b. rule rule-2 failed at path /metadata/labels/second/"},
+		{"rule-4", engineapi.RuleStatusPass, "validation rule 'rule-4' passed."},
+		{"rule-5", engineapi.RuleStatusSkip, "preconditions not met"},
+	}
+	assert.DeepEqual(t, expected, results(sequential))
+	assert.DeepEqual(t, expected, results(concurrent))
+	assert.Equal(t, sequential.PolicyResponse.RulesAppliedCount(), concurrent.PolicyResponse.RulesAppliedCount())
+	assert.Equal(t, sequential.PolicyResponse.RulesErrorCount(), concurrent.PolicyResponse.RulesErrorCount())
+}
+
+func Test_ValidateTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"value": "slow"}`))
+	}))
+	defer server.Close()
+	rawResource := []byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test"}, "spec": {"containers": [{"name": "nginx", "image": "nginx"}]}}`)
+	resource, err := kubeutils.BytesToUnstructured(rawResource)
+	assert.NilError(t, err)
+	testCases := []struct {
+		name            string
+		ruleTimeout     string
+		entryTimeout    string
+		expectedMessage string
+	}{{
+		name:            "rule timeout",
+		ruleTimeout:     `"timeout": "50ms",`,
+		expectedMessage: "timeout: rule exceeded timeout of 50ms",
+	}, {
+		name:            "context entry timeout",
+		entryTimeout:    `"timeout": "50ms",`,
+		expectedMessage: "context entry slow exceeded timeout of 50ms",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rawPolicy := []byte(`{
+				"apiVersion": "kyverno.io/v1",
+				"kind": "ClusterPolicy",
+				"metadata": {"name": "timeout"},
+				"spec": {
+					"rules": [{
+						"name": "slow",
+						` + tc.ruleTimeout + `
+						"match": {"resources": {"kinds": ["Pod"]}},
+						"context": [{"name": "slow", ` + tc.entryTimeout + ` "apiCall": {"method": "GET", "service": {"url": "` + server.URL + `"}}}],
+						"validate": {"message": "value must be slow", "deny": {"conditions": {"any": [{"key": "{{ slow.value }}", "operator": "NotEquals", "value": "slow"}]}}}
+					}]
+				}
+			}`)
+			var policy kyverno.ClusterPolicy
+			assert.NilError(t, json.Unmarshal(rawPolicy, &policy))
+			start := time.Now()
+			er := testValidate(context.TODO(), registryclient.NewOrDie(), newPolicyContext(t, *resource, kyverno.Create, nil).WithPolicy(&policy), cfg, nil)
+			assert.Assert(t, time.Since(start) < 5*time.Second)
+			assert.Equal(t, len(er.PolicyResponse.Rules), 1)
+			rule := er.PolicyResponse.Rules[0]
+			assert.Equal(t, rule.Status(), engineapi.RuleStatusError)
+			assert.Assert(t, rule.IsTimeout(), rule.Message())
+			assert.Assert(t, strings.Contains(rule.Message(), tc.expectedMessage), rule.Message())
+		})
+	}
+}
@@ -10,30 +10,56 @@ import (
 	stringutils "github.com/kyverno/kyverno/pkg/utils/strings"
 )
 
+// ConditionObserver is notified of every condition evaluated, along with the resolved key and value
+type ConditionObserver func(condition kyvernov1.Condition, key, value interface{}, result bool, err error)
+
 // Evaluate evaluates the condition
 func Evaluate(logger logr.Logger, ctx context.EvalInterface, condition kyvernov1.Condition) (bool, string, error) {
+	return evaluate(logger, ctx, condition, nil)
+}
+
+func evaluate(logger logr.Logger, ctx context.EvalInterface, condition kyvernov1.Condition, observer ConditionObserver) (bool, string, error) {
+	key, value, result, err := ResolveCondition(logger, ctx, condition)
+	if observer != nil {
+		observer(condition, key, value, result, err)
+	}
+	if err != nil {
+		return false, "", err
+	}
+	return result, condition.Message, nil
+}
+
+// ResolveCondition substitutes variables in the condition key and value and evaluates the condition,
+// it returns the resolved key and value along with the result
+func ResolveCondition(logger logr.Logger, ctx context.EvalInterface, condition kyvernov1.Condition) (interface{}, interface{}, bool, error) {
 	key, err := SubstituteAllInPreconditions(logger, ctx, condition.GetKey())
 	if err != nil {
-		return false, "", fmt.Errorf("failed to substitute variables in condition key: %w", err)
+		return nil, nil, false, fmt.Errorf("failed to substitute variables in condition key: %w", err)
 	}
 	value, err := SubstituteAllInPreconditions(logger, ctx, condition.GetValue())
 	if err != nil {
-		return false, "", fmt.Errorf("failed to substitute variables in condition value: %w", err)
+		return key, nil, false, fmt.Errorf("failed to substitute variables in condition value: %w", err)
 	}
 	handler := operator.CreateOperatorHandler(logger, ctx, condition.Operator)
 	if handler == nil {
-		return false, "", fmt.Errorf("failed to create handler for condition operator: %w", err)
+		return key, value, false, fmt.Errorf("failed to create handler for condition operator: %s", condition.Operator)
 	}
-	return handler.Evaluate(key, value), condition.Message, nil
+	return key, value, handler.Evaluate(key, value), nil
 }
 
 // EvaluateConditions evaluates all the conditions present in a slice, in a backwards compatible way
 func EvaluateConditions(log logr.Logger, ctx context.EvalInterface, conditions interface{}) (bool, string, error) {
+	return EvaluateConditionsWithObserver(log, ctx, conditions, nil)
+}
+
+// EvaluateConditionsWithObserver evaluates all the conditions present in a slice, the observer (if any)
+// is notified of every condition evaluated
+func EvaluateConditionsWithObserver(log logr.Logger, ctx context.EvalInterface, conditions interface{}, observer ConditionObserver) (bool, string, error) {
 	switch typedConditions := conditions.(type) {
 	case kyvernov1.AnyAllConditions:
-		return evaluateAnyAllConditions(log, ctx, typedConditions)
+		return evaluateAnyAllConditions(log, ctx, typedConditions, observer)
 	case []kyvernov1.Condition: // backwards compatibility
-		return evaluateOldConditions(log, ctx, typedConditions)
+		return evaluateOldConditions(log, ctx, typedConditions, observer)
 	}
 	return false, "", fmt.Errorf("invalid condition")
 }
@@ -41,7 +67,7 @@ func EvaluateConditions(log logr.Logger, ctx context.EvalInterface, conditions i
 func EvaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, conditions []kyvernov1.AnyAllConditions) (bool, string, error) {
 	var conditionTrueMessages []string
 	for _, c := range conditions {
-		if val, msg, err := evaluateAnyAllConditions(log, ctx, c); err != nil {
+		if val, msg, err := evaluateAnyAllConditions(log, ctx, c, nil); err != nil {
 			return false, "", err
 		} else if !val {
 			return false, msg, nil
@@ -54,7 +80,7 @@ func EvaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, condit
 }
 
 // evaluateAnyAllConditions evaluates multiple conditions as a logical AND (all) or OR (any) operation depending on the conditions
-func evaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, conditions kyvernov1.AnyAllConditions) (bool, string, error) {
+func evaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, conditions kyvernov1.AnyAllConditions, observer ConditionObserver) (bool, string, error) {
 	anyConditions, allConditions := conditions.AnyConditions, conditions.AllConditions
 	anyConditionsResult, allConditionsResult := true, true
 	var conditionFalseMessages []string
@@ -64,7 +90,7 @@ func evaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, condit
 	if anyConditions != nil {
 		anyConditionsResult = false
 		for _, condition := range anyConditions {
-			if val, msg, err := Evaluate(log, ctx, condition); err != nil {
+			if val, msg, err := evaluate(log, ctx, condition, observer); err != nil {
 				return false, "", err
 			} else if val {
 				anyConditionsResult = true
@@ -82,7 +108,7 @@ func evaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, condit
 
 	// update the allConditionsResult if they are present
 	for _, condition := range allConditions {
-		if val, msg, err := Evaluate(log, ctx, condition); err != nil {
+		if val, msg, err := evaluate(log, ctx, condition, observer); err != nil {
 			return false, "", err
 		} else if !val {
 			allConditionsResult = false
@@ -103,10 +129,10 @@ func evaluateAnyAllConditions(log logr.Logger, ctx context.EvalInterface, condit
 }
 
 // evaluateOldConditions evaluates multiple conditions when those conditions are provided in the old manner i.e. without 'any' or 'all'
-func evaluateOldConditions(log logr.Logger, ctx context.EvalInterface, conditions []kyvernov1.Condition) (bool, string, error) {
+func evaluateOldConditions(log logr.Logger, ctx context.EvalInterface, conditions []kyvernov1.Condition, observer ConditionObserver) (bool, string, error) {
 	var conditionTrueMessages []string
 	for _, condition := range conditions {
-		if val, msg, err := Evaluate(log, ctx, condition); err != nil {
+		if val, msg, err := evaluate(log, ctx, condition, observer); err != nil {
 			return false, "", err
 		} else if !val {
 			return false, msg, nil
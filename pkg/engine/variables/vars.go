@@ -318,9 +318,18 @@ func substituteReferencesIfAny(log logr.Logger) jsonUtils.Action {
 // VariableResolver defines the handler function for variable substitution
 type VariableResolver = func(ctx context.EvalInterface, variable string) (interface{}, error)
 
+// VariableObserver can be implemented by a context to be notified of the variables resolved by the DefaultVariableResolver
+type VariableObserver interface {
+	ObserveVariable(variable string, value interface{}, err error)
+}
+
 // DefaultVariableResolver is used in all variable substitutions except preconditions
 func DefaultVariableResolver(ctx context.EvalInterface, variable string) (interface{}, error) {
-	return ctx.Query(variable)
+	value, err := ctx.Query(variable)
+	if observer, ok := ctx.(VariableObserver); ok {
+		observer.ObserveVariable(variable, value, err)
+	}
+	return value, err
 }
 
 func substituteVariablesIfAny(log logr.Logger, ctx context.EvalInterface, vr VariableResolver) jsonUtils.Action {
@@ -369,7 +378,7 @@ func substituteVariablesIfAny(log logr.Logger, ctx context.EvalInterface, vr Var
 					case context.InvalidVariableError, gojmespath.NotFoundError:
 						return nil, err
 					default:
-						return nil, fmt.Errorf("failed to resolve %v at path %s: %v", variable, data.Path, err)
+						return nil, fmt.Errorf("failed to resolve %v at path %s: %w", variable, data.Path, err)
 					}
 				}
 
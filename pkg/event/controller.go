@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -9,9 +10,9 @@ import (
 	kyvernov1informers "github.com/kyverno/kyverno/pkg/client/informers/externalversions/kyverno/v1"
 	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
-	corev1 "k8s.io/api/core/v1"
 	errors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/cache"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
@@ -21,8 +22,36 @@ import (
 const (
 	eventWorkQueueName  = "kyverno-events"
 	workQueueRetryLimit = 3
+	// DefaultAggregationWindow is the default duration during which similar events are aggregated
+	DefaultAggregationWindow = 5 * time.Second
 )
 
+// aggregationKey identifies similar events, events with the same key are aggregated into a single event.
+// The message and details are part of the key so that events from different policies or rules are never merged.
+type aggregationKey struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Reason    Reason
+	Source    Source
+	Message   string
+	Details   Details
+}
+
+// aggregate holds the first event of a group of similar events and the number of events in the group
+type aggregate struct {
+	info  Info
+	count int
+}
+
+func (a aggregate) toInfo() Info {
+	info := a.info
+	if a.count > 1 {
+		info.Message = fmt.Sprintf("%s (and %d similar events)", info.Message, a.count-1)
+	}
+	return info
+}
+
 // generator generate events
 type generator struct {
 	client dclient.Interface
@@ -45,6 +74,15 @@ type generator struct {
 
 	omitEvents []string
 
+	// aggregationWindow is the minimum duration between two similar events, the first event is created immediately
+	// and similar events received within the window are aggregated into a single event created when the window ends
+	aggregationWindow time.Duration
+	// pending holds the events waiting in the queue
+	pending     map[aggregationKey]*aggregate
+	pendingLock sync.Mutex
+	// emitted holds the keys of the events created within the aggregation window
+	emitted *cache.Expiring
+
 	log logr.Logger
 }
 
@@ -67,6 +105,7 @@ func NewEventGenerator(
 	pInformer kyvernov1informers.PolicyInformer,
 	maxQueuedEvents int,
 	omitEvents []string,
+	aggregationWindow time.Duration,
 	log logr.Logger,
 ) Controller {
 	gen := generator{
@@ -80,6 +119,9 @@ func NewEventGenerator(
 		mutateExistingRecorder: NewRecorder(MutateExistingController, client.GetEventsInterface()),
 		maxQueuedEvents:        maxQueuedEvents,
 		omitEvents:             omitEvents,
+		aggregationWindow:      aggregationWindow,
+		pending:                map[aggregationKey]*aggregate{},
+		emitted:                cache.NewExpiring(),
 		log:                    log,
 	}
 	return &gen
@@ -89,8 +131,8 @@ func NewEventGenerator(
 func (gen *generator) Add(infos ...Info) {
 	logger := gen.log
 	logger.V(3).Info("generating events", "count", len(infos))
-	if gen.maxQueuedEvents == 0 || gen.queue.Len() > gen.maxQueuedEvents {
-		logger.V(2).Info("exceeds the event queue limit, dropping the event", "maxQueuedEvents", gen.maxQueuedEvents, "current size", gen.queue.Len())
+	if queued := gen.queued(); gen.maxQueuedEvents == 0 || queued > gen.maxQueuedEvents {
+		logger.V(2).Info("exceeds the event queue limit, dropping the event", "maxQueuedEvents", gen.maxQueuedEvents, "current size", queued)
 		return
 	}
 	for _, info := range infos {
@@ -110,12 +152,74 @@ func (gen *generator) Add(infos ...Info) {
 		}
 
 		if shouldEmitEvent {
-			gen.queue.Add(info)
-			logger.V(6).Info("creating event", "kind", info.Kind, "name", info.Name, "namespace", info.Namespace, "reason", info.Reason)
+			if gen.enqueue(info) {
+				logger.V(6).Info("creating event", "kind", info.Kind, "name", info.Name, "namespace", info.Namespace, "reason", info.Reason)
+			} else {
+				logger.V(6).Info("aggregating event", "kind", info.Kind, "name", info.Name, "namespace", info.Namespace, "reason", info.Reason)
+			}
 		}
 	}
 }
 
+// queued returns the number of events waiting to be created, aggregated events are counted once
+func (gen *generator) queued() int {
+	gen.pendingLock.Lock()
+	defer gen.pendingLock.Unlock()
+	return len(gen.pending)
+}
+
+// enqueue queues the event, it returns false if the event was aggregated with a similar event or delayed
+// because a similar event was created within the aggregation window
+func (gen *generator) enqueue(info Info) bool {
+	key := aggregationKey{
+		Kind:      info.Kind,
+		Name:      info.Name,
+		Namespace: info.Namespace,
+		Reason:    info.Reason,
+		Source:    info.Source,
+		Message:   info.Message,
+		Details:   info.Details,
+	}
+	gen.pendingLock.Lock()
+	defer gen.pendingLock.Unlock()
+	if pending, ok := gen.pending[key]; ok {
+		pending.count++
+		return false
+	}
+	gen.pending[key] = &aggregate{info: info, count: 1}
+	if _, ok := gen.emitted.Get(key); ok {
+		gen.queue.AddAfter(key, gen.aggregationWindow)
+		return false
+	}
+	gen.queue.Add(key)
+	return true
+}
+
+// dequeue returns the aggregated event for the given key and removes it from the pending events
+func (gen *generator) dequeue(key aggregationKey) (aggregate, bool) {
+	gen.pendingLock.Lock()
+	defer gen.pendingLock.Unlock()
+	pending, ok := gen.pending[key]
+	if !ok {
+		return aggregate{}, false
+	}
+	delete(gen.pending, key)
+	if gen.aggregationWindow > 0 {
+		gen.emitted.Set(key, nil, gen.aggregationWindow)
+	}
+	return *pending, true
+}
+
+// requeue puts back an aggregated event that failed to be created, merging it with similar events received in the meantime
+func (gen *generator) requeue(key aggregationKey, failed aggregate) {
+	gen.pendingLock.Lock()
+	defer gen.pendingLock.Unlock()
+	if pending, ok := gen.pending[key]; ok {
+		failed.count += pending.count
+	}
+	gen.pending[key] = &failed
+}
+
 // Run begins generator
 func (gen *generator) Run(ctx context.Context, workers int, waitGroup *sync.WaitGroup) {
 	logger := gen.log
@@ -138,7 +242,7 @@ func (gen *generator) runWorker(ctx context.Context) {
 	}
 }
 
-func (gen *generator) handleErr(err error, key interface{}) {
+func (gen *generator) handleErr(err error, key aggregationKey, event aggregate) {
 	logger := gen.log
 	if err == nil {
 		gen.queue.Forget(key)
@@ -149,6 +253,7 @@ func (gen *generator) handleErr(err error, key interface{}) {
 		logger.V(4).Info("retrying event generation", "key", key, "reason", err.Error())
 		// Re-enqueue the key rate limited. Based on the rate limiter on the
 		// queue and the re-enqueue history, the key will be processed later again.
+		gen.requeue(key, event)
 		gen.queue.AddRateLimited(key)
 		return
 	}
@@ -164,15 +269,20 @@ func (gen *generator) processNextWorkItem() bool {
 		return false
 	}
 	defer gen.queue.Done(obj)
-	var key Info
+	var key aggregationKey
 	var ok bool
-	if key, ok = obj.(Info); !ok {
+	if key, ok = obj.(aggregationKey); !ok {
 		gen.queue.Forget(obj)
-		gen.log.V(2).Info("Incorrect type; expected type 'info'", "obj", obj)
+		gen.log.V(2).Info("Incorrect type; expected type 'aggregationKey'", "obj", obj)
 		return true
 	}
-	err := gen.syncHandler(key)
-	gen.handleErr(err, obj)
+	event, ok := gen.dequeue(key)
+	if !ok {
+		gen.queue.Forget(obj)
+		return true
+	}
+	err := gen.syncHandler(event.toInfo())
+	gen.handleErr(err, key, event)
 	return true
 }
 
@@ -205,26 +315,28 @@ func (gen *generator) syncHandler(key Info) error {
 	}
 
 	// set the event type based on reason
-	// if skip/pass, reason will be: NORMAL
-	// else reason will be: WARNING
-	eventType := corev1.EventTypeWarning
-	if key.Reason == PolicyApplied || key.Reason == PolicySkipped {
-		eventType = corev1.EventTypeNormal
-	}
+	eventType := key.Reason.eventType()
 
 	logger.V(3).Info("creating the event", "source", key.Source, "type", eventType, "resource", key.Resource())
 	// based on the source of event generation, use different event recorders
+	var recorder record.EventRecorder
 	switch key.Source {
 	case AdmissionController:
-		gen.admissionCtrRecorder.Event(robj, eventType, string(key.Reason), key.Message)
+		recorder = gen.admissionCtrRecorder
 	case PolicyController:
-		gen.policyCtrRecorder.Event(robj, eventType, string(key.Reason), key.Message)
+		recorder = gen.policyCtrRecorder
 	case GeneratePolicyController:
-		gen.genPolicyRecorder.Event(robj, eventType, string(key.Reason), key.Message)
+		recorder = gen.genPolicyRecorder
 	case MutateExistingController:
-		gen.mutateExistingRecorder.Event(robj, eventType, string(key.Reason), key.Message)
+		recorder = gen.mutateExistingRecorder
 	default:
 		logger.Info("info.source not defined for the request")
+		return nil
+	}
+	if annotations := key.Details.Annotations(); annotations != nil {
+		recorder.AnnotatedEventf(robj, annotations, eventType, string(key.Reason), "%s", key.Message)
+	} else {
+		recorder.Event(robj, eventType, string(key.Reason), key.Message)
 	}
 	return nil
 }
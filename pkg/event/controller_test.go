@@ -0,0 +1,78 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func newTestGenerator(window time.Duration) *generator {
+	return &generator{
+		queue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultItemBasedRateLimiter(), eventWorkQueueName),
+		maxQueuedEvents:   1000,
+		aggregationWindow: window,
+		pending:           map[aggregationKey]*aggregate{},
+		emitted:           cache.NewExpiring(),
+		log:               logr.Discard(),
+	}
+}
+
+func Test_generator_aggregation(t *testing.T) {
+	gen := newTestGenerator(time.Hour)
+	defer gen.queue.ShutDown()
+	violation := func(policy, message string) Info {
+		return Info{Kind: "Pod", Namespace: "default", Name: "a", Reason: PolicyViolation, Source: AdmissionController, Message: message, Details: Details{Policy: policy}}
+	}
+	gen.Add(violation("require-labels", "fail"), violation("require-labels", "fail"), violation("require-labels", "fail"))
+	// events with a different message or details are not aggregated
+	gen.Add(violation("require-labels", "other"), violation("require-probes", "fail"))
+	assert.Equal(t, 3, gen.queued())
+	assert.Equal(t, 3, gen.queue.Len())
+
+	obj, _ := gen.queue.Get()
+	event, ok := gen.dequeue(obj.(aggregationKey))
+	assert.Assert(t, ok)
+	assert.Equal(t, "fail (and 2 similar events)", event.toInfo().Message)
+	assert.Equal(t, "require-labels", event.toInfo().Details.Policy)
+	gen.queue.Done(obj)
+
+	obj, _ = gen.queue.Get()
+	event, ok = gen.dequeue(obj.(aggregationKey))
+	assert.Assert(t, ok)
+	assert.Equal(t, "other", event.toInfo().Message)
+	gen.queue.Done(obj)
+
+	obj, _ = gen.queue.Get()
+	event, ok = gen.dequeue(obj.(aggregationKey))
+	assert.Assert(t, ok)
+	assert.Equal(t, "require-probes", event.toInfo().Details.Policy)
+	gen.queue.Done(obj)
+	assert.Equal(t, 0, gen.queued())
+
+	// a similar event received within the aggregation window is delayed
+	gen.Add(violation("require-labels", "fail"))
+	assert.Equal(t, 1, gen.queued())
+	assert.Equal(t, 0, gen.queue.Len())
+}
+
+func Test_generator_requeue(t *testing.T) {
+	gen := newTestGenerator(0)
+	defer gen.queue.ShutDown()
+	info := Info{Kind: "Pod", Namespace: "default", Name: "a", Reason: PolicyViolation, Source: AdmissionController, Message: "violation"}
+	gen.Add(info)
+	obj, _ := gen.queue.Get()
+	key := obj.(aggregationKey)
+	failed, ok := gen.dequeue(key)
+	assert.Assert(t, ok)
+	// a similar event is received while the first one is being processed
+	gen.Add(info)
+	gen.requeue(key, failed)
+	gen.queue.Done(obj)
+	event, ok := gen.dequeue(key)
+	assert.Assert(t, ok)
+	assert.Equal(t, 2, event.count)
+}
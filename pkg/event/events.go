@@ -6,9 +6,13 @@ import (
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/mattbaird/jsonpatch"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+// maxSummarizedPatches is the maximum number of patches described in a mutation event
+const maxSummarizedPatches = 10
+
 func NewPolicyFailEvent(source Source, reason Reason, engineResponse engineapi.EngineResponse, ruleResp engineapi.RuleResponse, blocked bool) Info {
 	return Info{
 		Kind:      getPolicyKind(engineResponse.Policy()),
@@ -152,6 +156,81 @@ func NewPolicyExceptionEvents(engineResponse engineapi.EngineResponse, ruleResp
 	return []Info{policyEvent, exceptionEvent}
 }
 
+func NewMutationConflictEvent(source Source, policy kyvernov1.PolicyInterface, message string) Info {
+	return Info{
+		Kind:      getPolicyKind(policy),
+		Name:      policy.GetName(),
+		Namespace: policy.GetNamespace(),
+		Reason:    PolicyConflict,
+		Source:    source,
+		Message:   message,
+	}
+}
+
+func NewResourceMutatedEvent(source Source, engineResponse engineapi.EngineResponse, ruleResp engineapi.RuleResponse) Info {
+	resource := engineResponse.GetResourceSpec()
+	policy := policyKey(engineResponse.Policy())
+	patches := summarizePatches(ruleResp.Patches())
+	return Info{
+		Kind:      resource.Kind,
+		Name:      resource.Name,
+		Namespace: resource.Namespace,
+		Reason:    ResourceMutated,
+		Source:    source,
+		Message:   fmt.Sprintf("policy %s/%s mutated the resource: %s", policy, ruleResp.Name(), patches),
+		Details: Details{
+			Policy:  policy,
+			Rule:    ruleResp.Name(),
+			Patches: patches,
+		},
+	}
+}
+
+func NewResourceGeneratedEvents(source Source, policy kyvernov1.PolicyInterface, rule string, trigger unstructured.Unstructured, targets ...kyvernov1.ResourceSpec) []Info {
+	var events []Info
+	for _, target := range targets {
+		// rules that didn't generate anything return an empty resource spec
+		if target.GetName() == "" {
+			continue
+		}
+		var targetKey string
+		if target.GetNamespace() != "" {
+			targetKey = strings.Join([]string{target.GetKind(), target.GetNamespace(), target.GetName()}, "/")
+		} else {
+			targetKey = strings.Join([]string{target.GetKind(), target.GetName()}, "/")
+		}
+		events = append(events, Info{
+			Kind:      trigger.GetKind(),
+			Name:      trigger.GetName(),
+			Namespace: trigger.GetNamespace(),
+			Reason:    ResourceGenerated,
+			Source:    source,
+			Message:   fmt.Sprintf("policy %s/%s generated %s", policyKey(policy), rule, targetKey),
+			Details: Details{
+				Policy: policyKey(policy),
+				Rule:   rule,
+				Target: target.String(),
+			},
+		})
+	}
+	return events
+}
+
+func NewResourceDriftedEvent(source Source, policy kyvernov1.PolicyInterface, rule string, downstream unstructured.Unstructured, reason string) Info {
+	return Info{
+		Kind:      downstream.GetKind(),
+		Name:      downstream.GetName(),
+		Namespace: downstream.GetNamespace(),
+		Reason:    ResourceDrifted,
+		Source:    source,
+		Message:   fmt.Sprintf("resource drifted from the state expected by policy %s/%s: %s", policyKey(policy), rule, reason),
+		Details: Details{
+			Policy: policyKey(policy),
+			Rule:   rule,
+		},
+	}
+}
+
 func NewFailedEvent(err error, policy, rule string, source Source, resource kyvernov1.ResourceSpec) Info {
 	return Info{
 		Kind:      resource.GetKind(),
@@ -163,6 +242,26 @@ func NewFailedEvent(err error, policy, rule string, source Source, resource kyve
 	}
 }
 
+// summarizePatches describes the operations and paths of the given patches
+func summarizePatches(patches []jsonpatch.JsonPatchOperation) string {
+	var operations []string
+	for i, patch := range patches {
+		if i == maxSummarizedPatches {
+			operations = append(operations, fmt.Sprintf("and %d more", len(patches)-maxSummarizedPatches))
+			break
+		}
+		operations = append(operations, patch.Operation+" "+patch.Path)
+	}
+	return strings.Join(operations, ", ")
+}
+
+func policyKey(policy kyvernov1.PolicyInterface) string {
+	if policy.GetNamespace() != "" {
+		return policy.GetNamespace() + "/" + policy.GetName()
+	}
+	return policy.GetName()
+}
+
 func resourceKey(resource unstructured.Unstructured) string {
 	if resource.GetNamespace() != "" {
 		return strings.Join([]string{resource.GetKind(), resource.GetNamespace(), resource.GetName()}, "/")
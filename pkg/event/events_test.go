@@ -0,0 +1,85 @@
+package event
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/mattbaird/jsonpatch"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newResource(kind, namespace, name string) unstructured.Unstructured {
+	var resource unstructured.Unstructured
+	resource.SetKind(kind)
+	resource.SetNamespace(namespace)
+	resource.SetName(name)
+	return resource
+}
+
+func TestNewResourceMutatedEvent(t *testing.T) {
+	policy := &kyvernov1.Policy{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "add-labels"}}
+	ruleResp := engineapi.RulePass("labels", engineapi.Mutation, "").WithPatches(
+		jsonpatch.NewPatch("add", "/metadata/labels/team", "a"),
+		jsonpatch.NewPatch("replace", "/spec/replicas", 2),
+	)
+	response := engineapi.NewEngineResponse(newResource("Deployment", "team", "nginx"), policy, nil)
+	info := NewResourceMutatedEvent(AdmissionController, response, *ruleResp)
+	assert.Equal(t, "Deployment/team/nginx", info.Resource())
+	assert.Equal(t, ResourceMutated, info.Reason)
+	assert.Equal(t, "policy team/add-labels/labels mutated the resource: add /metadata/labels/team, replace /spec/replicas", info.Message)
+	assert.DeepEqual(t, map[string]string{
+		"kyverno.io/policy":  "team/add-labels",
+		"kyverno.io/rule":    "labels",
+		"kyverno.io/patches": "add /metadata/labels/team, replace /spec/replicas",
+	}, info.Details.Annotations())
+}
+
+func TestNewResourceGeneratedEvents(t *testing.T) {
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default-netpol"}}
+	infos := NewResourceGeneratedEvents(
+		GeneratePolicyController,
+		policy,
+		"netpol",
+		newResource("Namespace", "", "team"),
+		kyvernov1.ResourceSpec{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy", Namespace: "team", Name: "default-deny"},
+		kyvernov1.ResourceSpec{},
+	)
+	assert.Equal(t, 1, len(infos))
+	assert.Equal(t, "Namespace/team", infos[0].Resource())
+	assert.Equal(t, ResourceGenerated, infos[0].Reason)
+	assert.Equal(t, "policy default-netpol/netpol generated NetworkPolicy/team/default-deny", infos[0].Message)
+	assert.Equal(t, "networking.k8s.io/v1/NetworkPolicy/team/default-deny", infos[0].Details.Target)
+}
+
+func TestNewResourceDriftedEvent(t *testing.T) {
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sync-secrets"}}
+	info := NewResourceDriftedEvent(GeneratePolicyController, policy, "regcred", newResource("Secret", "team", "regcred"), "value 'a' does not match 'b' at path /data/token/")
+	assert.Equal(t, "Secret/team/regcred", info.Resource())
+	assert.Equal(t, ResourceDrifted, info.Reason)
+	assert.Equal(t, "Warning", info.Reason.eventType())
+	assert.Equal(t, "resource drifted from the state expected by policy sync-secrets/regcred: value 'a' does not match 'b' at path /data/token/", info.Message)
+	assert.DeepEqual(t, map[string]string{
+		"kyverno.io/policy": "sync-secrets",
+		"kyverno.io/rule":   "regcred",
+	}, info.Details.Annotations())
+}
+
+func Test_summarizePatches(t *testing.T) {
+	var patches []jsonpatch.JsonPatchOperation
+	for i := 0; i < maxSummarizedPatches+2; i++ {
+		patches = append(patches, jsonpatch.NewPatch("add", fmt.Sprintf("/metadata/labels/l%d", i), "v"))
+	}
+	assert.Equal(t, "add /metadata/labels/l0", summarizePatches(patches[:1]))
+	assert.Assert(t, strings.HasSuffix(summarizePatches(patches), "add /metadata/labels/l9, and 2 more"))
+	assert.Equal(t, "", summarizePatches(nil))
+}
+
+func TestDetails_Annotations(t *testing.T) {
+	assert.Assert(t, Details{}.Annotations() == nil)
+	assert.DeepEqual(t, map[string]string{"kyverno.io/target": "v1/ConfigMap/ns/name"}, Details{Target: "v1/ConfigMap/ns/name"}.Annotations())
+}
@@ -10,6 +10,7 @@ type Info struct {
 	Reason    Reason
 	Message   string
 	Source    Source
+	Details   Details
 }
 
 func (i *Info) Resource() string {
@@ -18,3 +19,42 @@ func (i *Info) Resource() string {
 	}
 	return strings.Join([]string{i.Kind, i.Namespace, i.Name}, "/")
 }
+
+// Details holds structured details about an event, they are recorded as event annotations.
+// All fields are optional.
+type Details struct {
+	// Policy is the policy that triggered the event
+	Policy string
+	// Rule is the rule that triggered the event
+	Rule string
+	// Patches is a summary of the patches applied by a mutation
+	Patches string
+	// Target is the resource created or updated by a generate rule
+	Target string
+}
+
+const (
+	policyAnnotation  = "kyverno.io/policy"
+	ruleAnnotation    = "kyverno.io/rule"
+	patchesAnnotation = "kyverno.io/patches"
+	targetAnnotation  = "kyverno.io/target"
+)
+
+// Annotations returns the details as event annotations, nil if no detail is set
+func (d Details) Annotations() map[string]string {
+	var annotations map[string]string
+	add := func(key, value string) {
+		if value == "" {
+			return
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+	}
+	add(policyAnnotation, d.Policy)
+	add(ruleAnnotation, d.Rule)
+	add(patchesAnnotation, d.Patches)
+	add(targetAnnotation, d.Target)
+	return annotations
+}
@@ -1,5 +1,7 @@
 package event
 
+import corev1 "k8s.io/api/core/v1"
+
 // Reason types of Event Reasons
 type Reason string
 
@@ -8,4 +10,21 @@ const (
 	PolicyApplied   Reason = "PolicyApplied"
 	PolicyError     Reason = "PolicyError"
 	PolicySkipped   Reason = "PolicySkipped"
+	PolicyConflict  Reason = "PolicyConflict"
+	// ResourceMutated is recorded on a resource mutated by a policy rule
+	ResourceMutated Reason = "ResourceMutated"
+	// ResourceGenerated is recorded on the trigger of a generate rule
+	ResourceGenerated Reason = "ResourceGenerated"
+	// ResourceDrifted is recorded on a generated resource that no longer matches its generate rule
+	ResourceDrifted Reason = "ResourceDrifted"
 )
+
+// eventType returns the event type of the reason
+func (r Reason) eventType() string {
+	switch r {
+	case PolicyApplied, PolicySkipped, ResourceMutated, ResourceGenerated:
+		return corev1.EventTypeNormal
+	default:
+		return corev1.EventTypeWarning
+	}
+}
@@ -39,6 +39,8 @@ const (
 	Warn  RuleResult = "warn"
 	Error RuleResult = "error"
 	Skip  RuleResult = "skip"
+	// Timeout is an error caused by a rule or context entry exceeding its timeout
+	Timeout RuleResult = "timeout"
 )
 
 type RuleExecutionCause string
@@ -29,8 +29,12 @@ const (
 
 type MetricsConfig struct {
 	// instruments
-	policyChangesMetric metric.Int64Counter
-	clientQueriesMetric metric.Int64Counter
+	policyChangesMetric        metric.Int64Counter
+	clientQueriesMetric        metric.Int64Counter
+	contextEntryDurationMetric metric.Float64Histogram
+	contextEntryErrorsMetric   metric.Int64Counter
+	configMapCacheMetric       metric.Int64Counter
+	registryRequestsMetric     metric.Int64Counter
 
 	// config
 	config kconfig.MetricsConfiguration
@@ -41,6 +45,10 @@ type MetricsConfigManager interface {
 	Config() kconfig.MetricsConfiguration
 	RecordPolicyChanges(ctx context.Context, policyValidationMode PolicyValidationMode, policyType PolicyType, policyBackgroundMode PolicyBackgroundMode, policyNamespace string, policyName string, policyChangeType string)
 	RecordClientQueries(ctx context.Context, clientQueryOperation ClientQueryOperation, clientType ClientType, resourceKind string, resourceNamespace string)
+	RecordContextEntryDuration(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, duration float64)
+	RecordContextEntryErrors(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, cause string)
+	RecordConfigMapCacheLookups(ctx context.Context, configMapNamespace string, hit bool)
+	RecordRegistryRequests(ctx context.Context, registryHost string)
 }
 
 func (m *MetricsConfig) Config() kconfig.MetricsConfiguration {
@@ -60,6 +68,26 @@ func (m *MetricsConfig) initializeMetrics(meterProvider metric.MeterProvider) er
 		m.Log.Error(err, "Failed to create instrument, kyverno_client_queries")
 		return err
 	}
+	m.contextEntryDurationMetric, err = meter.Float64Histogram("kyverno_context_entry_duration_seconds", metric.WithDescription("can be used to track the latencies (in seconds) associated with the loading of the context entries of the policy rules"))
+	if err != nil {
+		m.Log.Error(err, "Failed to create instrument, kyverno_context_entry_duration_seconds")
+		return err
+	}
+	m.contextEntryErrorsMetric, err = meter.Int64Counter("kyverno_context_entry_errors", metric.WithDescription("can be used to track the errors, by cause, that occurred when loading the context entries of the policy rules"))
+	if err != nil {
+		m.Log.Error(err, "Failed to create instrument, kyverno_context_entry_errors")
+		return err
+	}
+	m.configMapCacheMetric, err = meter.Int64Counter("kyverno_configmap_cache_lookups", metric.WithDescription("can be used to track the hit rate of the config maps cache used to resolve the configMap context entries"))
+	if err != nil {
+		m.Log.Error(err, "Failed to create instrument, kyverno_configmap_cache_lookups")
+		return err
+	}
+	m.registryRequestsMetric, err = meter.Int64Counter("kyverno_registry_requests", metric.WithDescription("can be used to track the number of requests sent from Kyverno to the image registries"))
+	if err != nil {
+		m.Log.Error(err, "Failed to create instrument, kyverno_registry_requests")
+		return err
+	}
 	return nil
 }
 
@@ -205,3 +233,51 @@ func (m *MetricsConfig) RecordClientQueries(ctx context.Context, clientQueryOper
 	}
 	m.clientQueriesMetric.Add(ctx, 1, metric.WithAttributes(commonLabels...))
 }
+
+func (m *MetricsConfig) RecordContextEntryDuration(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, duration float64) {
+	if !m.config.CheckNamespace(policyNamespace) {
+		return
+	}
+	commonLabels := []attribute.KeyValue{
+		attribute.String("context_entry_type", entryType),
+		attribute.String("policy_namespace", policyNamespace),
+		attribute.String("policy_name", policyName),
+		attribute.String("rule_name", ruleName),
+	}
+	m.contextEntryDurationMetric.Record(ctx, duration, metric.WithAttributes(commonLabels...))
+}
+
+func (m *MetricsConfig) RecordContextEntryErrors(ctx context.Context, entryType string, policyNamespace string, policyName string, ruleName string, cause string) {
+	if !m.config.CheckNamespace(policyNamespace) {
+		return
+	}
+	commonLabels := []attribute.KeyValue{
+		attribute.String("context_entry_type", entryType),
+		attribute.String("policy_namespace", policyNamespace),
+		attribute.String("policy_name", policyName),
+		attribute.String("rule_name", ruleName),
+		attribute.String("error_cause", cause),
+	}
+	m.contextEntryErrorsMetric.Add(ctx, 1, metric.WithAttributes(commonLabels...))
+}
+
+func (m *MetricsConfig) RecordConfigMapCacheLookups(ctx context.Context, configMapNamespace string, hit bool) {
+	if !m.config.CheckNamespace(configMapNamespace) {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	commonLabels := []attribute.KeyValue{
+		attribute.String("configmap_namespace", configMapNamespace),
+		attribute.String("cache_result", result),
+	}
+	m.configMapCacheMetric.Add(ctx, 1, metric.WithAttributes(commonLabels...))
+}
+
+// RecordRegistryRequests records a request sent to a registry, registries are not namespaced
+// and the requests are recorded regardless of the namespaces filtering
+func (m *MetricsConfig) RecordRegistryRequests(ctx context.Context, registryHost string) {
+	m.registryRequestsMetric.Add(ctx, 1, metric.WithAttributes(attribute.String("registry_host", registryHost)))
+}
@@ -7,13 +7,16 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/kyverno/kyverno/pkg/images"
 	"github.com/kyverno/kyverno/pkg/logging"
+	"github.com/kyverno/kyverno/pkg/tracing"
 	_ "github.com/notaryproject/notation-core-go/signature/cose"
 	_ "github.com/notaryproject/notation-core-go/signature/jws"
 	"github.com/notaryproject/notation-go"
 	"github.com/notaryproject/notation-go/verifier"
 	"github.com/notaryproject/notation-go/verifier/trustpolicy"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 )
 
@@ -59,7 +62,18 @@ func (v *notaryV2Verifier) VerifySignature(ctx context.Context, opts images.Opti
 		MaxSignatureAttempts: 10,
 	}
 
-	targetDesc, outcomes, err := notation.Verify(context.TODO(), notationVerifier, repo, remoteVerifyOptions)
+	targetDesc, outcomes, err := tracing.ChildSpan3(
+		ctx,
+		"pkg/notaryv2",
+		"VERIFY IMG SIGS",
+		func(ctx context.Context, span trace.Span) (ocispec.Descriptor, []*notation.VerificationOutcome, error) {
+			return notation.Verify(ctx, notationVerifier, repo, remoteVerifyOptions)
+		},
+		trace.WithAttributes(
+			tracing.ImageReferenceKey.String(opts.ImageRef),
+			tracing.ImageVerifierKey.String("notaryv2"),
+		),
+	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to verify %s", ref)
 	}
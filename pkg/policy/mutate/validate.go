@@ -3,9 +3,11 @@ package mutate
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/engine/mutate/patch"
 	"github.com/kyverno/kyverno/pkg/engine/variables/regex"
 	"github.com/kyverno/kyverno/pkg/utils/api"
 	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
@@ -30,15 +32,19 @@ func NewMutateFactory(m kyvernov1.Mutation, client dclient.Interface, user strin
 // Validate validates the 'mutate' rule
 func (m *Mutate) Validate(ctx context.Context) (string, error) {
 	if m.hasForEach() {
-		if m.hasPatchStrategicMerge() || m.hasPatchesJSON6902() {
-			return "foreach", fmt.Errorf("only one of `foreach`, `patchStrategicMerge`, or `patchesJson6902` is allowed")
+		if m.hasPatchStrategicMerge() || m.hasPatchesJSON6902() || m.hasPatchJSONMerge() || m.hasExpressions() {
+			return "foreach", fmt.Errorf("only one of `foreach`, `patchStrategicMerge`, `patchesJson6902`, `patchJsonMerge` or `expressions` is allowed")
 		}
 
 		return m.validateForEach("", m.mutation.ForEachMutation)
 	}
 
-	if m.hasPatchesJSON6902() && m.hasPatchStrategicMerge() {
-		return "foreach", fmt.Errorf("only one of `patchStrategicMerge` or `patchesJson6902` is allowed")
+	if countPatches(m.hasPatchStrategicMerge(), m.hasPatchesJSON6902(), m.hasPatchJSONMerge(), m.hasExpressions()) > 1 {
+		return "foreach", fmt.Errorf("only one of `patchStrategicMerge`, `patchesJson6902`, `patchJsonMerge` or `expressions` is allowed")
+	}
+
+	if path, err := validateExpressions("expressions", m.mutation.Expressions); err != nil {
+		return path, err
 	}
 
 	if m.mutation.Targets != nil {
@@ -53,16 +59,19 @@ func (m *Mutate) validateForEach(tag string, foreach []kyvernov1.ForEachMutation
 	for i, fe := range foreach {
 		tag = tag + fmt.Sprintf("foreach[%d]", i)
 		if fe.ForEachMutation != nil {
-			if fe.Context != nil || fe.AnyAllConditions != nil || fe.PatchesJSON6902 != "" || fe.RawPatchStrategicMerge != nil {
+			if fe.Context != nil || fe.AnyAllConditions != nil || fe.PatchesJSON6902 != "" || fe.RawPatchStrategicMerge != nil || fe.RawPatchJSONMerge != nil || len(fe.Expressions) > 0 {
 				return tag, fmt.Errorf("a nested foreach cannot contain other declarations")
 			}
 
 			return m.validateNestedForEach(tag, fe.ForEachMutation)
 		}
 
-		psm := fe.GetPatchStrategicMerge()
-		if (fe.PatchesJSON6902 == "" && psm == nil) || (fe.PatchesJSON6902 != "" && psm != nil) {
-			return tag, fmt.Errorf("only one of `patchStrategicMerge` or `patchesJson6902` is allowed")
+		if countPatches(fe.GetPatchStrategicMerge() != nil, fe.PatchesJSON6902 != "", fe.GetPatchJSONMerge() != nil, len(fe.Expressions) > 0) != 1 {
+			return tag, fmt.Errorf("only one of `patchStrategicMerge`, `patchesJson6902`, `patchJsonMerge` or `expressions` is allowed")
+		}
+
+		if path, err := validateExpressions(tag+".expressions", fe.Expressions); err != nil {
+			return path, err
 		}
 	}
 
@@ -90,6 +99,42 @@ func (m *Mutate) hasPatchesJSON6902() bool {
 	return m.mutation.PatchesJSON6902 != ""
 }
 
+func (m *Mutate) hasPatchJSONMerge() bool {
+	return m.mutation.GetPatchJSONMerge() != nil
+}
+
+func (m *Mutate) hasExpressions() bool {
+	return len(m.mutation.Expressions) > 0
+}
+
+func countPatches(patches ...bool) int {
+	count := 0
+	for _, patch := range patches {
+		if patch {
+			count++
+		}
+	}
+	return count
+}
+
+func validateExpressions(tag string, expressions []kyvernov1.MutationExpression) (string, error) {
+	for i, expression := range expressions {
+		path := fmt.Sprintf("%s[%d]", tag, i)
+		if !strings.HasPrefix(expression.Path, "/") {
+			return path + ".path", fmt.Errorf("path must be a JSON pointer starting with `/`")
+		}
+		if (expression.JMESPath == "") == (expression.CEL == "") {
+			return path, fmt.Errorf("only one of `jmesPath` or `cel` is allowed")
+		}
+		if expression.CEL != "" {
+			if _, err := patch.CompileCEL(expression.CEL); err != nil {
+				return path + ".cel", fmt.Errorf("invalid CEL expression: %w", err)
+			}
+		}
+	}
+	return "", nil
+}
+
 func (m *Mutate) validateAuth(ctx context.Context, targets []kyvernov1.TargetResourceSpec) error {
 	var errs []error
 	for _, target := range targets {
@@ -37,6 +37,7 @@ import (
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -84,6 +85,9 @@ type policyController struct {
 
 	reconcilePeriod time.Duration
 
+	// sweepRateLimiter limits the creation of update requests by the scheduled sweeps of the mutateExisting rules
+	sweepRateLimiter flowcontrol.RateLimiter
+
 	log logr.Logger
 
 	metricsConfig metrics.MetricsConfigManager
@@ -106,6 +110,7 @@ func NewPolicyController(
 	reconcilePeriod time.Duration,
 	metricsConfig metrics.MetricsConfigManager,
 	jp jmespath.Interface,
+	sweepRateLimiter flowcontrol.RateLimiter,
 ) (*policyController, error) {
 	// Event broad caster
 	eventBroadcaster := record.NewBroadcaster()
@@ -114,19 +119,20 @@ func NewPolicyController(
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: eventInterface})
 
 	pc := policyController{
-		client:          client,
-		kyvernoClient:   kyvernoClient,
-		engine:          engine,
-		pInformer:       pInformer,
-		npInformer:      npInformer,
-		eventGen:        eventGen,
-		eventRecorder:   eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "policy_controller"}),
-		queue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "policy"),
-		configuration:   configuration,
-		reconcilePeriod: reconcilePeriod,
-		metricsConfig:   metricsConfig,
-		log:             log,
-		jp:              jp,
+		client:           client,
+		kyvernoClient:    kyvernoClient,
+		engine:           engine,
+		pInformer:        pInformer,
+		npInformer:       npInformer,
+		eventGen:         eventGen,
+		eventRecorder:    eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "policy_controller"}),
+		queue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "policy"),
+		configuration:    configuration,
+		reconcilePeriod:  reconcilePeriod,
+		sweepRateLimiter: sweepRateLimiter,
+		metricsConfig:    metricsConfig,
+		log:              log,
+		jp:               jp,
 	}
 
 	pc.pLister = pInformer.Lister()
@@ -283,6 +289,8 @@ func (pc *policyController) Run(ctx context.Context, workers int) {
 
 	go pc.forceReconciliation(ctx)
 
+	go pc.sweepScheduledRules(ctx)
+
 	<-ctx.Done()
 }
 
@@ -0,0 +1,157 @@
+package policy
+
+import (
+	"context"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	kyvernov1beta1 "github.com/kyverno/kyverno/api/kyverno/v1beta1"
+	backgroundcommon "github.com/kyverno/kyverno/pkg/background/common"
+	controllerutils "github.com/kyverno/kyverno/pkg/utils/controller"
+	retryutils "github.com/kyverno/kyverno/pkg/utils/retry"
+	"github.com/robfig/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// sweepPeriod is the period at which the schedules of the mutateExisting rules are evaluated
+const sweepPeriod = time.Minute
+
+// sweepScheduledRules periodically creates update requests for the triggers of the mutateExisting rules whose schedule is due
+func (pc *policyController) sweepScheduledRules(ctx context.Context) {
+	ticker := time.NewTicker(sweepPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			pc.sweepPolicies(ctx, now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (pc *policyController) sweepPolicies(ctx context.Context, now time.Time) {
+	logger := pc.log.WithName("sweepPolicies")
+	var policies []kyvernov1.PolicyInterface
+	if cpols, err := pc.pLister.List(labels.Everything()); err == nil {
+		for _, cpol := range cpols {
+			policies = append(policies, cpol)
+		}
+	} else {
+		logger.Error(err, "unable to list ClusterPolicies")
+	}
+	if pols, err := pc.npLister.Policies(metav1.NamespaceAll).List(labels.Everything()); err == nil {
+		for _, pol := range pols {
+			policies = append(policies, pol)
+		}
+	} else {
+		logger.Error(err, "unable to list Policies")
+	}
+	for _, policy := range policies {
+		if !pc.canBackgroundProcess(policy) {
+			continue
+		}
+		if err := pc.sweepPolicy(ctx, policy, now); err != nil {
+			logger.Error(err, "failed to sweep policy", "policy", policy.GetName())
+		}
+	}
+}
+
+func (pc *policyController) sweepPolicy(ctx context.Context, policy kyvernov1.PolicyInterface, now time.Time) error {
+	logger := pc.log.WithName("sweepPolicy").WithValues("policy", policy.GetName())
+	var sweeps []kyvernov1.SweepStatus
+	for _, rule := range policy.GetSpec().Rules {
+		if !rule.IsMutateExisting() || rule.Mutation.Schedule == "" {
+			continue
+		}
+		schedule, err := cron.ParseStandard(rule.Mutation.Schedule)
+		if err != nil {
+			logger.Error(err, "invalid schedule", "rule", rule.Name, "schedule", rule.Mutation.Schedule)
+			continue
+		}
+		if !sweepDue(schedule, policy, rule.Name, now) {
+			continue
+		}
+		count, err := pc.sweepRule(ctx, policy, rule)
+		if err != nil {
+			return err
+		}
+		logger.V(2).Info("swept mutateExisting rule", "rule", rule.Name, "updateRequests", count)
+		sweeps = append(sweeps, kyvernov1.SweepStatus{
+			Rule:          rule.Name,
+			LastSweepTime: metav1.NewTime(now),
+			Count:         count,
+		})
+	}
+	if len(sweeps) == 0 {
+		return nil
+	}
+	return pc.updateSweepStatus(ctx, policy, sweeps)
+}
+
+// sweepDue returns true if the schedule was reached since the last sweep of the rule,
+// or since the creation of the policy if the rule was never swept
+func sweepDue(schedule cron.Schedule, policy kyvernov1.PolicyInterface, rule string, now time.Time) bool {
+	last := policy.GetCreationTimestamp().Time
+	if sweep := policy.GetStatus().GetSweep(rule); sweep != nil {
+		last = sweep.LastSweepTime.Time
+	}
+	return !schedule.Next(last).After(now)
+}
+
+// sweepRule creates an update request for every trigger of the rule without a pending update request,
+// the creation of update requests is rate limited
+func (pc *policyController) sweepRule(ctx context.Context, policy kyvernov1.PolicyInterface, rule kyvernov1.Rule) (int, error) {
+	policyKey := backgroundcommon.PolicyKey(policy.GetNamespace(), policy.GetName())
+	count := 0
+	for _, trigger := range generateTriggers(pc.client, rule, pc.log) {
+		if murs := pc.listMutateURs(policyKey, trigger); len(murs) != 0 {
+			continue
+		}
+		if err := pc.sweepRateLimiter.Wait(ctx); err != nil {
+			return count, err
+		}
+		ur := newUR(policy, backgroundcommon.ResourceSpecFromUnstructured(*trigger), rule.Name, kyvernov1beta1.Mutate, false)
+		skip, err := pc.handleUpdateRequest(ur, trigger, rule, policy)
+		if err != nil {
+			pc.log.Error(err, "failed to create new UR on scheduled sweep", "policy", policy.GetName(), "rule", rule.Name,
+				"trigger", backgroundcommon.ResourceSpecFromUnstructured(*trigger).String())
+			continue
+		}
+		if !skip {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (pc *policyController) updateSweepStatus(ctx context.Context, policy kyvernov1.PolicyInterface, sweeps []kyvernov1.SweepStatus) error {
+	setSweeps := func(status *kyvernov1.PolicyStatus) {
+		for _, sweep := range sweeps {
+			status.SetSweep(sweep.Rule, sweep.LastSweepTime, sweep.Count)
+		}
+	}
+	return retryutils.RetryFunc(ctx, time.Second, 10*time.Second, pc.log, "failed to update sweep status", func(ctx context.Context) error {
+		if policy.GetNamespace() == "" {
+			latest, err := pc.kyvernoClient.KyvernoV1().ClusterPolicies().Get(ctx, policy.GetName(), metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			_, err = controllerutils.UpdateStatus(ctx, latest, pc.kyvernoClient.KyvernoV1().ClusterPolicies(), func(policy *kyvernov1.ClusterPolicy) error {
+				setSweeps(&policy.Status)
+				return nil
+			})
+			return err
+		}
+		latest, err := pc.kyvernoClient.KyvernoV1().Policies(policy.GetNamespace()).Get(ctx, policy.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		_, err = controllerutils.UpdateStatus(ctx, latest, pc.kyvernoClient.KyvernoV1().Policies(policy.GetNamespace()), func(policy *kyvernov1.Policy) error {
+			setSweeps(&policy.Status)
+			return nil
+		})
+		return err
+	})()
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/robfig/cron"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_sweepDue(t *testing.T) {
+	created := time.Date(2023, 1, 1, 0, 30, 0, 0, time.UTC)
+	schedule, err := cron.ParseStandard("0 * * * *")
+	assert.NilError(t, err)
+	policy := &kyvernov1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sweep", CreationTimestamp: metav1.NewTime(created)}}
+	assert.Equal(t, false, sweepDue(schedule, policy, "rule", created.Add(20*time.Minute)))
+	assert.Equal(t, true, sweepDue(schedule, policy, "rule", created.Add(30*time.Minute)))
+	policy.Status.SetSweep("rule", metav1.NewTime(created.Add(30*time.Minute)), 3)
+	assert.Equal(t, false, sweepDue(schedule, policy, "rule", created.Add(45*time.Minute)))
+	assert.Equal(t, true, sweepDue(schedule, policy, "rule", created.Add(90*time.Minute)))
+	// other rules are tracked independently
+	assert.Equal(t, true, sweepDue(schedule, policy, "other", created.Add(45*time.Minute)))
+	policy.Status.SetSweep("rule", metav1.NewTime(created.Add(90*time.Minute)), 1)
+	assert.Equal(t, 1, len(policy.Status.Sweeps))
+	assert.Equal(t, 1, policy.Status.GetSweep("rule").Count)
+}
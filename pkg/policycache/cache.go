@@ -6,6 +6,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/utils/wildcard"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
 )
 
 type ResourceFinder interface {
@@ -21,6 +22,10 @@ type Cache interface {
 	// GetPolicies returns all policies that apply to a namespace, including cluster-wide policies
 	// If the namespace is empty, only cluster-wide policies are returned
 	GetPolicies(PolicyType, schema.GroupVersionResource, string, string) []kyvernov1.PolicyInterface
+	// GetCandidates returns the policies that may apply to a request, policies are further filtered
+	// using the operations and namespace selectors of their rules.
+	// Every candidate comes with the reasons its rules were selected
+	GetCandidates(PolicyType, schema.GroupVersionResource, string, string, MatchRequest) []Candidate
 }
 
 type cache struct {
@@ -58,6 +63,22 @@ func (c *cache) GetPolicies(pkey PolicyType, gvr schema.GroupVersionResource, su
 	return result
 }
 
+func (c *cache) GetCandidates(pkey PolicyType, gvr schema.GroupVersionResource, subresource string, nspace string, request MatchRequest) []Candidate {
+	var result []Candidate
+	for _, policy := range c.GetPolicies(pkey, gvr, subresource, nspace) {
+		key, err := kcache.MetaNamespaceKeyFunc(policy)
+		if err != nil {
+			logger.Error(err, "failed to compute policy key", "policy", policy.GetName())
+			result = append(result, Candidate{Policy: policy})
+			continue
+		}
+		if reasons, ok := c.store.match(pkey, gvr, subresource, key, request); ok {
+			result = append(result, Candidate{Policy: policy, Reasons: reasons})
+		}
+	}
+	return result
+}
+
 // Filter cluster policies using validationFailureAction override
 func filterPolicies(pkey PolicyType, result []kyvernov1.PolicyInterface, nspace string) []kyvernov1.PolicyInterface {
 	var policies []kyvernov1.PolicyInterface
@@ -6,6 +6,7 @@ import (
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/autogen"
+	"github.com/kyverno/kyverno/pkg/clients/dclient"
 	kubeutils "github.com/kyverno/kyverno/pkg/utils/kube"
 	"gotest.tools/assert"
 	kubecache "k8s.io/client-go/tools/cache"
@@ -1260,3 +1261,127 @@ func Test_Get_Policies_Validate_Failure_Action_Overrides(t *testing.T) {
 		t.Errorf("expected 2 validate enforce policy, found %v", len(validateEnforce))
 	}
 }
+
+func newOperationsPolicy(t *testing.T) *kyvernov1.ClusterPolicy {
+	rawPolicy := []byte(`{
+		"metadata": {
+			"name": "restricted"
+		},
+		"spec": {
+			"validationFailureAction": "enforce",
+			"rules": [
+				{
+					"name": "deny-delete",
+					"match": {
+						"any": [
+							{
+								"resources": {
+									"kinds": ["Pod"],
+									"operations": ["DELETE"]
+								}
+							}
+						]
+					},
+					"validate": {
+						"deny": {}
+					}
+				},
+				{
+					"name": "team-namespaces",
+					"match": {
+						"all": [
+							{
+								"resources": {
+									"kinds": ["Pod"],
+									"operations": ["CREATE", "UPDATE"],
+									"namespaceSelector": {
+										"matchLabels": {
+											"team": "platform"
+										}
+									}
+								}
+							}
+						]
+					},
+					"validate": {
+						"pattern": {
+							"metadata": {
+								"labels": {
+									"team": "?*"
+								}
+							}
+						}
+					}
+				}
+			]
+		}
+	}`)
+	var policy *kyvernov1.ClusterPolicy
+	err := json.Unmarshal(rawPolicy, &policy)
+	assert.NilError(t, err)
+	return policy
+}
+
+func Test_Get_Candidates(t *testing.T) {
+	cache := NewCache()
+	policy := newOperationsPolicy(t)
+	finder := TestResourceFinder{}
+	key, _ := kubecache.MetaNamespaceKeyFunc(policy)
+	assert.NilError(t, cache.Set(key, policy, finder))
+	tests := []struct {
+		name    string
+		gvr     dclient.TopLevelApiDescription
+		request MatchRequest
+		reasons []MatchReason
+	}{{
+		name:    "no constraint",
+		gvr:     podsGVRS,
+		request: MatchRequest{},
+		reasons: []MatchReason{
+			{Rule: "deny-delete", Reason: "no operation or namespace selector constraint"},
+			{Rule: "team-namespaces", Reason: "no operation or namespace selector constraint"},
+		},
+	}, {
+		name:    "delete",
+		gvr:     podsGVRS,
+		request: MatchRequest{Operation: kyvernov1.Delete, NamespaceLabels: map[string]string{}},
+		reasons: []MatchReason{
+			{Rule: "deny-delete", Reason: "operation DELETE matched"},
+		},
+	}, {
+		name:    "create in team namespace",
+		gvr:     podsGVRS,
+		request: MatchRequest{Operation: kyvernov1.Create, NamespaceLabels: map[string]string{"team": "platform"}},
+		reasons: []MatchReason{
+			{Rule: "team-namespaces", Reason: "operation CREATE matched, namespace selector team=platform matched"},
+		},
+	}, {
+		name:    "create in other namespace",
+		gvr:     podsGVRS,
+		request: MatchRequest{Operation: kyvernov1.Create, NamespaceLabels: map[string]string{"team": "apps"}},
+	}, {
+		name:    "connect",
+		gvr:     podsGVRS,
+		request: MatchRequest{Operation: kyvernov1.Connect},
+	}, {
+		name:    "other resource",
+		gvr:     clusterrolesGVRS,
+		request: MatchRequest{Operation: kyvernov1.Delete},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := cache.GetCandidates(ValidateEnforce, tt.gvr.GroupVersionResource(), "", "", tt.request)
+			if tt.reasons == nil {
+				assert.Equal(t, len(candidates), 0)
+				return
+			}
+			assert.Equal(t, len(candidates), 1)
+			assert.Equal(t, candidates[0].Policy.GetName(), "restricted")
+			assert.DeepEqual(t, candidates[0].Reasons, tt.reasons)
+			// the policy is not returned for other policy types
+			assert.Equal(t, len(cache.GetCandidates(Mutate, tt.gvr.GroupVersionResource(), "", "", tt.request)), 0)
+		})
+	}
+	cache.Unset(key)
+	assert.Equal(t, len(cache.GetCandidates(ValidateEnforce, podsGVRS.GroupVersionResource(), "", "", MatchRequest{})), 0)
+}
@@ -0,0 +1,152 @@
+package policycache
+
+import (
+	"fmt"
+	"strings"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// MatchRequest holds the request attributes used to pre-filter policies
+type MatchRequest struct {
+	// Operation is the admission operation, policies are not filtered on operations if empty
+	Operation kyvernov1.AdmissionOperation
+	// NamespaceLabels are the labels of the request namespace, policies are not filtered on namespace selectors if nil
+	NamespaceLabels map[string]string
+}
+
+// MatchReason explains why a rule was selected for a request
+type MatchReason struct {
+	// Rule is the rule name
+	Rule string
+	// Reason describes the constraints that matched
+	Reason string
+}
+
+func (r MatchReason) String() string {
+	return fmt.Sprintf("%s: %s", r.Rule, r.Reason)
+}
+
+// Candidate is a policy that may apply to a request, along with the rules that were selected
+type Candidate struct {
+	Policy  kyvernov1.PolicyInterface
+	Reasons []MatchReason
+}
+
+// matchBlock holds the operations and namespace selector of a match resource filter, a nil value means no constraint
+type matchBlock struct {
+	operations        sets.Set[kyvernov1.AdmissionOperation]
+	namespaceSelector labels.Selector
+}
+
+// ruleIndex holds the indexed constraints of a rule
+type ruleIndex struct {
+	name string
+	// types is the union of policy types the rule contributes to
+	types PolicyType
+	// keys are the resources matched by the rule
+	keys sets.Set[policyKey]
+	// any is true if a single block needs to match, otherwise all blocks need to match
+	any    bool
+	blocks []matchBlock
+}
+
+func newMatchBlock(description kyvernov1.ResourceDescription) matchBlock {
+	var block matchBlock
+	if len(description.Operations) > 0 {
+		block.operations = sets.New(description.Operations...)
+	}
+	if description.NamespaceSelector != nil {
+		// an invalid selector is not indexed, the engine will report the error
+		if selector, err := metav1.LabelSelectorAsSelector(description.NamespaceSelector); err == nil {
+			block.namespaceSelector = selector
+		}
+	}
+	return block
+}
+
+func newRuleIndex(rule kyvernov1.Rule, keys sets.Set[policyKey]) ruleIndex {
+	index := ruleIndex{
+		name: rule.Name,
+		keys: keys,
+	}
+	if rule.HasMutate() {
+		index.types |= Mutate
+	}
+	if rule.HasValidate() {
+		index.types |= ValidateEnforce | ValidateAudit
+	}
+	if rule.HasGenerate() {
+		index.types |= Generate
+	}
+	if rule.HasVerifyImages() {
+		index.types |= VerifyImagesMutate | VerifyImagesValidate
+	}
+	if len(rule.MatchResources.Any) > 0 {
+		index.any = true
+		for _, filter := range rule.MatchResources.Any {
+			index.blocks = append(index.blocks, newMatchBlock(filter.ResourceDescription))
+		}
+	} else if len(rule.MatchResources.All) > 0 {
+		for _, filter := range rule.MatchResources.All {
+			index.blocks = append(index.blocks, newMatchBlock(filter.ResourceDescription))
+		}
+	} else {
+		index.blocks = append(index.blocks, newMatchBlock(rule.MatchResources.ResourceDescription))
+	}
+	return index
+}
+
+// match returns true if the block may match the request, along with the constraints that matched
+func (b matchBlock) match(request MatchRequest, checkNamespace bool) (bool, []string) {
+	var reasons []string
+	if b.operations != nil && request.Operation != "" {
+		if !b.operations.Has(request.Operation) {
+			return false, nil
+		}
+		reasons = append(reasons, fmt.Sprintf("operation %s matched", request.Operation))
+	}
+	if b.namespaceSelector != nil && checkNamespace && request.NamespaceLabels != nil {
+		if !b.namespaceSelector.Matches(labels.Set(request.NamespaceLabels)) {
+			return false, nil
+		}
+		reasons = append(reasons, fmt.Sprintf("namespace selector %s matched", b.namespaceSelector))
+	}
+	return true, reasons
+}
+
+// match returns true if the rule may match the request, along with the reason
+func (r ruleIndex) match(pkey PolicyType, key policyKey, request MatchRequest) (bool, string) {
+	if r.types&pkey == 0 || !r.keys.Has(key) {
+		return false, ""
+	}
+	// namespace selectors don't apply to namespaces
+	checkNamespace := !(key.Group == "" && key.Resource == "namespaces")
+	if r.any {
+		for _, block := range r.blocks {
+			if matched, reasons := block.match(request, checkNamespace); matched {
+				return true, formatReasons(reasons)
+			}
+		}
+		return false, ""
+	}
+	var reasons []string
+	for _, block := range r.blocks {
+		matched, blockReasons := block.match(request, checkNamespace)
+		if !matched {
+			return false, ""
+		}
+		reasons = append(reasons, blockReasons...)
+	}
+	return true, formatReasons(reasons)
+}
+
+func formatReasons(reasons []string) string {
+	if len(reasons) == 0 {
+		return "no operation or namespace selector constraint"
+	}
+	return strings.Join(reasons, ", ")
+}
@@ -19,6 +19,8 @@ type store interface {
 	unset(string)
 	// get finds policies that match a given type, gvr, subresource and namespace
 	get(PolicyType, schema.GroupVersionResource, string, string) []kyvernov1.PolicyInterface
+	// match checks the indexed rules of a policy against a request, it returns the reasons for rules that may apply
+	match(PolicyType, schema.GroupVersionResource, string, string, MatchRequest) ([]MatchReason, bool)
 }
 
 type policyCache struct {
@@ -55,6 +57,12 @@ func (pc *policyCache) get(pkey PolicyType, gvr schema.GroupVersionResource, sub
 	return pc.store.get(pkey, gvr, subresource, nspace)
 }
 
+func (pc *policyCache) match(pkey PolicyType, gvr schema.GroupVersionResource, subresource string, key string, request MatchRequest) ([]MatchReason, bool) {
+	pc.lock.RLock()
+	defer pc.lock.RUnlock()
+	return pc.store.match(pkey, gvr, subresource, key, request)
+}
+
 type policyKey struct {
 	Group       string
 	Version     string
@@ -70,12 +78,15 @@ type policyMap struct {
 	// kindType stores names of ClusterPolicies and Namespaced Policies.
 	// They are accessed first by GVRS then by PolicyType.
 	kindType map[policyKey]map[PolicyType]sets.Set[string]
+	// rules stores the indexed rules (operations and namespace selectors) of every policy
+	rules map[string][]ruleIndex
 }
 
 func newPolicyMap() *policyMap {
 	return &policyMap{
 		policies: map[string]kyvernov1.PolicyInterface{},
 		kindType: map[policyKey]map[PolicyType]sets.Set[string]{},
+		rules:    map[string][]ruleIndex{},
 	}
 }
 
@@ -107,6 +118,7 @@ func (m *policyMap) set(key string, policy kyvernov1.PolicyInterface, client Res
 		hasMutate, hasValidate, hasGenerate, hasVerifyImages, hasImagesValidationChecks bool
 	}
 	kindStates := map[policyKey]state{}
+	var rules []ruleIndex
 	for _, rule := range autogen.ComputeRules(policy) {
 		entries := sets.New[policyKey]()
 		for _, gvk := range rule.MatchResources.GetKinds() {
@@ -136,6 +148,7 @@ func (m *policyMap) set(key string, policy kyvernov1.PolicyInterface, client Res
 					SubResource: "ephemeralcontainers",
 				})
 			}
+			rules = append(rules, newRuleIndex(rule, entries))
 			hasMutate := rule.HasMutate()
 			hasValidate := rule.HasValidate()
 			hasGenerate := rule.HasGenerate()
@@ -152,6 +165,7 @@ func (m *policyMap) set(key string, policy kyvernov1.PolicyInterface, client Res
 			}
 		}
 	}
+	m.rules[key] = rules
 	for gvrs, state := range kindStates {
 		if m.kindType[gvrs] == nil {
 			m.kindType[gvrs] = map[PolicyType]sets.Set[string]{
@@ -175,6 +189,7 @@ func (m *policyMap) set(key string, policy kyvernov1.PolicyInterface, client Res
 
 func (m *policyMap) unset(key string) {
 	delete(m.policies, key)
+	delete(m.rules, key)
 	for gvrs := range m.kindType {
 		for policyType := range m.kindType[gvrs] {
 			m.kindType[gvrs][policyType] = m.kindType[gvrs][policyType].Delete(key)
@@ -205,3 +220,19 @@ func (m *policyMap) get(key PolicyType, gvr schema.GroupVersionResource, subreso
 	}
 	return result
 }
+
+func (m *policyMap) match(pkey PolicyType, gvr schema.GroupVersionResource, subresource string, key string, request MatchRequest) ([]MatchReason, bool) {
+	rules, ok := m.rules[key]
+	if !ok {
+		// the policy is not indexed, don't filter it out
+		return nil, true
+	}
+	pKey := policyKey{gvr.Group, gvr.Version, gvr.Resource, subresource}
+	var reasons []MatchReason
+	for _, rule := range rules {
+		if matched, reason := rule.match(pkey, pKey, request); matched {
+			reasons = append(reasons, MatchReason{Rule: rule.name, Reason: reason})
+		}
+	}
+	return reasons, len(reasons) > 0
+}
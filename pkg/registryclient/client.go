@@ -78,6 +78,7 @@ type config struct {
 	transport           *http.Transport
 	pullSecretRefresher func(context.Context, *client) error
 	tracing             bool
+	metrics             MetricsRecorder
 }
 
 // Option is an option to initialize registry client.
@@ -102,6 +103,12 @@ func New(options ...Option) (Client, error) {
 	if cfg.tracing {
 		c.transport = tracing.Transport(cfg.transport, otelhttp.WithFilter(tracing.RequestFilterIsInSpan))
 	}
+	if cfg.metrics != nil {
+		c.transport = &metricsTransport{
+			inner:   c.transport,
+			metrics: cfg.metrics,
+		}
+	}
 	return c, nil
 }
 
@@ -182,6 +189,14 @@ func WithTracing() Option {
 	}
 }
 
+// WithMetrics enables recording the requests sent to registries.
+func WithMetrics(metrics MetricsRecorder) Option {
+	return func(c *config) error {
+		c.metrics = metrics
+		return nil
+	}
+}
+
 // BuildRemoteOption builds remote.Option based on client.
 func (c *client) BuildRemoteOption(ctx context.Context) remote.Option {
 	return remote.WithRemoteOptions(
@@ -0,0 +1,22 @@
+package registryclient
+
+import (
+	"context"
+	"net/http"
+)
+
+// MetricsRecorder records the requests sent to registries, it is implemented by metrics.MetricsConfigManager
+type MetricsRecorder interface {
+	RecordRegistryRequests(ctx context.Context, registryHost string)
+}
+
+// metricsTransport records every request sent through the inner transport
+type metricsTransport struct {
+	inner   http.RoundTripper
+	metrics MetricsRecorder
+}
+
+func (t *metricsTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.metrics.RecordRegistryRequests(request.Context(), request.URL.Host)
+	return t.inner.RoundTrip(request)
+}
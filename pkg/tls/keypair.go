@@ -1,6 +1,10 @@
 package tls
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -14,13 +18,71 @@ import (
 	"github.com/kyverno/kyverno/pkg/config"
 )
 
+// KeyAlgorithm is the algorithm used to generate private keys
+type KeyAlgorithm string
+
+const (
+	// RSA generates 2048 bits RSA keys
+	RSA KeyAlgorithm = "RSA"
+	// ECDSA generates ECDSA keys on the P-256 curve
+	ECDSA KeyAlgorithm = "ECDSA"
+	// Ed25519 generates Ed25519 keys
+	Ed25519 KeyAlgorithm = "Ed25519"
+)
+
+// ParseKeyAlgorithm parses a key algorithm, the comparison is case insensitive
+func ParseKeyAlgorithm(value string) (KeyAlgorithm, error) {
+	for _, algorithm := range []KeyAlgorithm{RSA, ECDSA, Ed25519} {
+		if strings.EqualFold(value, string(algorithm)) {
+			return algorithm, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported key algorithm %s, must be one of %s, %s or %s", value, RSA, ECDSA, Ed25519)
+}
+
+// generateKey creates a private key using the given algorithm
+func generateKey(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case ECDSA:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case Ed25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+}
+
+// keyMatchesAlgorithm returns true if the key was generated with the given algorithm
+func keyMatchesAlgorithm(key crypto.Signer, algorithm KeyAlgorithm) bool {
+	switch key.(type) {
+	case *ecdsa.PrivateKey:
+		return algorithm == ECDSA
+	case ed25519.PrivateKey:
+		return algorithm == Ed25519
+	case *rsa.PrivateKey:
+		return algorithm == RSA || algorithm == ""
+	default:
+		return false
+	}
+}
+
+// keyUsage returns the key usage for the given key, key encipherment is only used with RSA keys
+func keyUsage(key crypto.Signer) x509.KeyUsage {
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		return x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	}
+	return x509.KeyUsageDigitalSignature
+}
+
 // generateCA creates the self-signed CA cert and private key
 // it will be used to sign the webhook server certificate
-func generateCA(key *rsa.PrivateKey, certValidityDuration time.Duration) (*rsa.PrivateKey, *x509.Certificate, error) {
+// the existing key is reused unless it doesn't match the key algorithm
+func generateCA(key crypto.Signer, algorithm KeyAlgorithm, certValidityDuration time.Duration) (crypto.Signer, *x509.Certificate, error) {
 	now := time.Now()
 	begin, end := now.Add(-1*time.Hour), now.Add(certValidityDuration)
-	if key == nil {
-		newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if key == nil || !keyMatchesAlgorithm(key, algorithm) {
+		newKey, err := generateKey(algorithm)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -33,7 +95,7 @@ func generateCA(key *rsa.PrivateKey, certValidityDuration time.Duration) (*rsa.P
 		},
 		NotBefore:             begin,
 		NotAfter:              end,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		KeyUsage:              keyUsage(key) | x509.KeyUsageCertSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 	}
@@ -50,7 +112,7 @@ func generateCA(key *rsa.PrivateKey, certValidityDuration time.Duration) (*rsa.P
 
 // generateTLS takes the results of GenerateCACert and uses it to create the
 // PEM-encoded public certificate and private key, respectively
-func generateTLS(server string, caCert *x509.Certificate, caKey *rsa.PrivateKey, certValidityDuration time.Duration) (*rsa.PrivateKey, *x509.Certificate, error) {
+func generateTLS(server string, caCert *x509.Certificate, caKey crypto.Signer, algorithm KeyAlgorithm, certValidityDuration time.Duration) (crypto.Signer, *x509.Certificate, error) {
 	now := time.Now()
 	begin, end := now.Add(-1*time.Hour), now.Add(certValidityDuration)
 	dnsNames := []string{
@@ -77,6 +139,10 @@ func generateTLS(server string, caCert *x509.Certificate, caKey *rsa.PrivateKey,
 			}
 		}
 	}
+	key, err := generateKey(algorithm)
+	if err != nil {
+		return nil, nil, err
+	}
 	templ := &x509.Certificate{
 		SerialNumber: big.NewInt(1),
 		Subject: pkix.Name{
@@ -86,14 +152,10 @@ func generateTLS(server string, caCert *x509.Certificate, caKey *rsa.PrivateKey,
 		IPAddresses:           ips,
 		NotBefore:             begin,
 		NotAfter:              end,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		KeyUsage:              keyUsage(key),
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
 	}
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return nil, nil, err
-	}
 	der, err := x509.CreateCertificate(rand.Reader, templ, caCert, key.Public(), caKey)
 	if err != nil {
 		logger.Error(err, "create certificate failed")
@@ -0,0 +1,69 @@
+package tls
+
+import (
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestParseKeyAlgorithm(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    KeyAlgorithm
+		wantErr bool
+	}{
+		{value: "rsa", want: RSA},
+		{value: "ECDSA", want: ECDSA},
+		{value: "Ed25519", want: Ed25519},
+		{value: "dsa", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseKeyAlgorithm(tt.value)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	for _, algorithm := range []KeyAlgorithm{RSA, ECDSA, Ed25519} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			caKey, caCert, err := generateCA(nil, algorithm, time.Hour)
+			assert.NilError(t, err)
+			assert.Assert(t, keyMatchesAlgorithm(caKey, algorithm))
+			tlsKey, tlsCert, err := generateTLS("", caCert, caKey, algorithm, time.Hour)
+			assert.NilError(t, err)
+			assert.Assert(t, keyMatchesAlgorithm(tlsKey, algorithm))
+			assert.Assert(t, validateCert(time.Now(), tlsCert, caCert))
+			// the encoded pair can be loaded by the webhook server
+			keyBytes, err := privateKeyToPem(tlsKey)
+			assert.NilError(t, err)
+			_, err = tls.X509KeyPair(certificateToPem(tlsCert), keyBytes)
+			assert.NilError(t, err)
+			decoded, err := pemToPrivateKey(keyBytes)
+			assert.NilError(t, err)
+			assert.Assert(t, keyMatchesAlgorithm(decoded, algorithm))
+		})
+	}
+}
+
+func TestGenerateCAKeyAlgorithmChange(t *testing.T) {
+	rsaKey, _, err := generateCA(nil, RSA, time.Hour)
+	assert.NilError(t, err)
+	// the existing key is reused when it matches the algorithm
+	key, _, err := generateCA(rsaKey, RSA, time.Hour)
+	assert.NilError(t, err)
+	assert.Equal(t, rsaKey, key)
+	// a new key is generated when the algorithm changes
+	key, _, err = generateCA(rsaKey, ECDSA, time.Hour)
+	assert.NilError(t, err)
+	assert.Assert(t, keyMatchesAlgorithm(key, ECDSA))
+}
@@ -0,0 +1,138 @@
+package tls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"github.com/kyverno/kyverno/pkg/metrics"
+	controllerutils "github.com/kyverno/kyverno/pkg/utils/controller"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	corev1 "k8s.io/api/core/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// KeyPairCache holds the parsed TLS key pair served by the webhook server.
+// The key pair is parsed once and refreshed when the TLS secret is renewed.
+type KeyPairCache struct {
+	lister corev1listers.SecretNamespaceLister
+
+	lock         sync.RWMutex
+	certPem      []byte
+	keyPem       []byte
+	certificate  *tls.Certificate
+	lastRotation time.Time
+
+	expirationMetric   metric.Float64ObservableGauge
+	lastRotationMetric metric.Float64ObservableGauge
+}
+
+// NewKeyPairCache creates a key pair cache watching the TLS secret through the given informer
+func NewKeyPairCache(secretInformer corev1informers.SecretInformer) *KeyPairCache {
+	c := &KeyPairCache{
+		lister: secretInformer.Lister().Secrets(config.KyvernoNamespace()),
+	}
+	meter := global.MeterProvider().Meter(metrics.MeterName)
+	expirationMetric, err := meter.Float64ObservableGauge(
+		"kyverno_tls_certificate_expiration_timestamp_seconds",
+		metric.WithDescription("can be used to track the expiration time (unix timestamp) of the certificate served by the webhook server"),
+	)
+	if err != nil {
+		logger.Error(err, "Failed to create instrument, kyverno_tls_certificate_expiration_timestamp_seconds")
+	}
+	lastRotationMetric, err := meter.Float64ObservableGauge(
+		"kyverno_tls_certificate_last_rotation_timestamp_seconds",
+		metric.WithDescription("can be used to track the last time (unix timestamp) the certificate served by the webhook server was loaded"),
+	)
+	if err != nil {
+		logger.Error(err, "Failed to create instrument, kyverno_tls_certificate_last_rotation_timestamp_seconds")
+	}
+	c.expirationMetric = expirationMetric
+	c.lastRotationMetric = lastRotationMetric
+	if c.expirationMetric != nil && c.lastRotationMetric != nil {
+		if _, err := meter.RegisterCallback(c.report, c.expirationMetric, c.lastRotationMetric); err != nil {
+			logger.Error(err, "Failed to register callback")
+		}
+	}
+	controllerutils.AddEventHandlersT(
+		secretInformer.Informer(),
+		func(secret *corev1.Secret) { c.onSecretChange(secret) },
+		func(_, secret *corev1.Secret) { c.onSecretChange(secret) },
+		// the current key pair is kept until a new one is available
+		func(*corev1.Secret) {},
+	)
+	return c
+}
+
+// GetCertificate returns the cached key pair, it is loaded from the TLS secret if not cached yet
+func (c *KeyPairCache) GetCertificate() (*tls.Certificate, error) {
+	c.lock.RLock()
+	certificate := c.certificate
+	c.lock.RUnlock()
+	if certificate != nil {
+		return certificate, nil
+	}
+	if err := c.Refresh(); err != nil {
+		return nil, err
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.certificate, nil
+}
+
+// Refresh reads the TLS secret and parses the key pair if it changed
+func (c *KeyPairCache) Refresh() error {
+	secret, err := c.lister.Get(GenerateTLSPairSecretName())
+	if err != nil {
+		return err
+	}
+	certPem, keyPem := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.certificate != nil && bytes.Equal(c.certPem, certPem) && bytes.Equal(c.keyPem, keyPem) {
+		return nil
+	}
+	pair, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		return err
+	}
+	// parse the leaf once, it is used during handshakes and to report the expiration time
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return err
+	}
+	pair.Leaf = leaf
+	c.certPem, c.keyPem = certPem, keyPem
+	c.certificate = &pair
+	c.lastRotation = time.Now()
+	logger.Info("loaded TLS key pair", "secret", secret.Name, "notAfter", leaf.NotAfter)
+	return nil
+}
+
+func (c *KeyPairCache) onSecretChange(secret *corev1.Secret) {
+	if secret.GetNamespace() != config.KyvernoNamespace() || secret.GetName() != GenerateTLSPairSecretName() {
+		return
+	}
+	if err := c.Refresh(); err != nil {
+		logger.Error(err, "failed to refresh TLS key pair", "secret", secret.GetName())
+	}
+}
+
+func (c *KeyPairCache) report(ctx context.Context, observer metric.Observer) error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.certificate == nil {
+		return nil
+	}
+	attributes := metric.WithAttributes(attribute.String("secret_name", GenerateTLSPairSecretName()))
+	observer.ObserveFloat64(c.expirationMetric, float64(c.certificate.Leaf.NotAfter.Unix()), attributes)
+	observer.ObserveFloat64(c.lastRotationMetric, float64(c.lastRotation.Unix()), attributes)
+	return nil
+}
@@ -0,0 +1,68 @@
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTLSSecret(t *testing.T) *corev1.Secret {
+	caKey, caCert, err := generateCA(nil, ECDSA, time.Hour)
+	assert.NilError(t, err)
+	tlsKey, tlsCert, err := generateTLS("", caCert, caKey, ECDSA, time.Hour)
+	assert.NilError(t, err)
+	keyBytes, err := privateKeyToPem(tlsKey)
+	assert.NilError(t, err)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: config.KyvernoNamespace(),
+			Name:      GenerateTLSPairSecretName(),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certificateToPem(tlsCert),
+			corev1.TLSPrivateKeyKey: keyBytes,
+		},
+	}
+}
+
+func TestKeyPairCache(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	secret := newTLSSecret(t)
+	client := fake.NewSimpleClientset(secret)
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(client, 0, kubeinformers.WithNamespace(config.KyvernoNamespace()))
+	cache := NewKeyPairCache(factory.Core().V1().Secrets())
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+	first, err := cache.GetCertificate()
+	assert.NilError(t, err)
+	assert.Assert(t, first.Leaf != nil)
+	// the key pair is parsed once
+	second, err := cache.GetCertificate()
+	assert.NilError(t, err)
+	assert.Equal(t, first, second)
+	// the key pair is refreshed when the secret is renewed
+	renewed := newTLSSecret(t)
+	_, err = client.CoreV1().Secrets(config.KyvernoNamespace()).Update(ctx, renewed, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	var third *tls.Certificate
+	for i := 0; i < 50; i++ {
+		third, err = cache.GetCertificate()
+		assert.NilError(t, err)
+		if third != first {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Assert(t, third != first)
+	assert.Assert(t, !third.Leaf.Equal(first.Leaf))
+}
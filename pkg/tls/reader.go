@@ -17,8 +17,15 @@ func ReadRootCASecret(client corev1listers.SecretNamespaceLister) ([]byte, error
 	if err != nil {
 		return nil, err
 	}
+	var result []byte
+	// externally managed secrets store the CA in "ca.crt"
+	if IsExternallyManaged() {
+		result = stlsca.Data[caKey]
+	}
 	// try "tls.crt"
-	result := stlsca.Data[corev1.TLSCertKey]
+	if len(result) == 0 {
+		result = stlsca.Data[corev1.TLSCertKey]
+	}
 	// if not there, try old "rootCA.crt"
 	if len(result) == 0 {
 		result = stlsca.Data[rootCAKey]
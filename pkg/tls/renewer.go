@@ -2,7 +2,7 @@ package tls
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 	"time"
@@ -26,6 +26,8 @@ const (
 	// managedByLabel is added to Kyverno managed secrets
 	managedByLabel = "cert.kyverno.io/managed-by"
 	rootCAKey      = "rootCA.crt"
+	// caKey is the CA certificate key used by cert-manager
+	caKey = "ca.crt"
 )
 
 type CertValidator interface {
@@ -49,6 +51,9 @@ type certRenewer struct {
 	certRenewalInterval time.Duration
 	caValidityDuration  time.Duration
 	tlsValidityDuration time.Duration
+	keyAlgorithm        KeyAlgorithm
+	// external is true when certificates are managed outside of Kyverno, they are verified but never renewed
+	external bool
 
 	// server is an IP address or domain name where Kyverno controller runs. Only required if out-of-cluster.
 	server string
@@ -61,6 +66,7 @@ func NewCertRenewer(
 	certRenewalInterval,
 	caValidityDuration,
 	tlsValidityDuration time.Duration,
+	keyAlgorithm KeyAlgorithm,
 	server string,
 ) *certRenewer {
 	return &certRenewer{
@@ -69,12 +75,17 @@ func NewCertRenewer(
 		certRenewalInterval: certRenewalInterval,
 		caValidityDuration:  caValidityDuration,
 		tlsValidityDuration: tlsValidityDuration,
+		keyAlgorithm:        keyAlgorithm,
+		external:            IsExternallyManaged(),
 		server:              server,
 	}
 }
 
 // RenewCA renews the CA certificate if needed
 func (c *certRenewer) RenewCA(ctx context.Context) error {
+	if c.external {
+		return c.checkExternalCA()
+	}
 	secret, key, certs, err := c.decodeCASecret()
 	if err != nil && !apierrors.IsNotFound(err) {
 		logger.Error(err, "failed to read CA")
@@ -82,7 +93,7 @@ func (c *certRenewer) RenewCA(ctx context.Context) error {
 	}
 	now := time.Now()
 	certs = removeExpiredCertificates(now, certs...)
-	if !allCertificatesExpired(now.Add(5*c.certRenewalInterval), certs...) {
+	if !allCertificatesExpired(now.Add(5*c.certRenewalInterval), certs...) && (key == nil || keyMatchesAlgorithm(key, c.keyAlgorithm)) {
 		logger.V(4).Info("CA certificate does not need to be renewed")
 		return nil
 	}
@@ -99,7 +110,7 @@ func (c *certRenewer) RenewCA(ctx context.Context) error {
 		}
 		return err
 	}
-	caKey, caCert, err := generateCA(key, c.caValidityDuration)
+	caKey, caCert, err := generateCA(key, c.keyAlgorithm, c.caValidityDuration)
 	if err != nil {
 		logger.Error(err, "failed to generate CA")
 		return err
@@ -115,18 +126,29 @@ func (c *certRenewer) RenewCA(ctx context.Context) error {
 
 // RenewTLS renews the TLS certificate if needed
 func (c *certRenewer) RenewTLS(ctx context.Context) error {
+	if c.external {
+		return c.checkExternalTLS()
+	}
 	_, caKey, caCerts, err := c.decodeCASecret()
 	if err != nil {
 		logger.Error(err, "failed to read CA")
 		return err
 	}
-	secret, _, cert, err := c.decodeTLSSecret()
+	if len(caCerts) == 0 {
+		err := fmt.Errorf("%s in secret %s/%s", ErrorsNotFound, config.KyvernoNamespace(), GenerateRootCASecretName())
+		logger.Error(err, "failed to read CA")
+		return err
+	}
+	secret, key, cert, err := c.decodeTLSSecret()
 	if err != nil && !apierrors.IsNotFound(err) {
 		logger.Error(err, "failed to read TLS")
 		return err
 	}
 	now := time.Now()
-	if cert != nil && !allCertificatesExpired(now.Add(5*c.certRenewalInterval), cert) {
+	// the certificate is renewed when it expires, when the key algorithm changed or when it was not issued by the current CA
+	if cert != nil && !allCertificatesExpired(now.Add(5*c.certRenewalInterval), cert) &&
+		(key == nil || keyMatchesAlgorithm(key, c.keyAlgorithm)) &&
+		validateCert(now, cert, caCerts[len(caCerts)-1]) {
 		logger.V(4).Info("TLS certificate does not need to be renewed")
 		return nil
 	}
@@ -143,7 +165,7 @@ func (c *certRenewer) RenewTLS(ctx context.Context) error {
 		}
 		return err
 	}
-	tlsKey, tlsCert, err := generateTLS(c.server, caCerts[len(caCerts)-1], caKey, c.tlsValidityDuration)
+	tlsKey, tlsCert, err := generateTLS(c.server, caCerts[len(caCerts)-1], caKey, c.keyAlgorithm, c.tlsValidityDuration)
 	if err != nil {
 		logger.Error(err, "failed to generate TLS")
 		return err
@@ -166,9 +188,45 @@ func (c *certRenewer) ValidateCert() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if cert == nil {
+		return false, nil
+	}
 	return validateCert(time.Now(), cert, caCerts...), nil
 }
 
+// checkExternalCA verifies the externally managed CA certificate exists
+func (c *certRenewer) checkExternalCA() error {
+	_, _, caCerts, err := c.decodeCASecret()
+	if err != nil {
+		logger.Error(err, "failed to read externally managed CA")
+		return err
+	}
+	if len(caCerts) == 0 {
+		err := fmt.Errorf("%s in secret %s/%s", ErrorsNotFound, config.KyvernoNamespace(), GenerateRootCASecretName())
+		logger.Error(err, "failed to read externally managed CA")
+		return err
+	}
+	return nil
+}
+
+// checkExternalTLS verifies the externally managed TLS certificate exists, a warning is logged when it is about to expire
+func (c *certRenewer) checkExternalTLS() error {
+	_, _, cert, err := c.decodeTLSSecret()
+	if err != nil {
+		logger.Error(err, "failed to read externally managed TLS")
+		return err
+	}
+	if cert == nil {
+		err := fmt.Errorf("TLS certificate not found in secret %s/%s", config.KyvernoNamespace(), GenerateTLSPairSecretName())
+		logger.Error(err, "failed to read externally managed TLS")
+		return err
+	}
+	if allCertificatesExpired(time.Now().Add(5*c.certRenewalInterval), cert) {
+		logger.Info("WARNING: externally managed TLS certificate is about to expire and can't be renewed by kyverno", "notAfter", cert.NotAfter)
+	}
+	return nil
+}
+
 func (c *certRenewer) getSecret(name string) (*corev1.Secret, error) {
 	if s, err := c.lister.Get(name); err != nil {
 		return nil, err
@@ -177,7 +235,8 @@ func (c *certRenewer) getSecret(name string) (*corev1.Secret, error) {
 	}
 }
 
-func (c *certRenewer) decodeSecret(name string) (*corev1.Secret, *rsa.PrivateKey, []*x509.Certificate, error) {
+// decodeSecret decodes the private key and the certificates stored in the secret, certificates are read from the first non empty key
+func (c *certRenewer) decodeSecret(name string, certKeys ...string) (*corev1.Secret, crypto.Signer, []*x509.Certificate, error) {
 	secret, err := c.getSecret(name)
 	if err != nil {
 		return nil, nil, nil, err
@@ -185,12 +244,13 @@ func (c *certRenewer) decodeSecret(name string) (*corev1.Secret, *rsa.PrivateKey
 	var certBytes, keyBytes []byte
 	if secret != nil {
 		keyBytes = secret.Data[corev1.TLSPrivateKeyKey]
-		certBytes = secret.Data[corev1.TLSCertKey]
-		if len(certBytes) == 0 {
-			certBytes = secret.Data[rootCAKey]
+		for _, certKey := range certKeys {
+			if certBytes = secret.Data[certKey]; len(certBytes) != 0 {
+				break
+			}
 		}
 	}
-	var key *rsa.PrivateKey
+	var key crypto.Signer
 	if keyBytes != nil {
 		usedkey, err := pemToPrivateKey(keyBytes)
 		if err != nil {
@@ -201,25 +261,26 @@ func (c *certRenewer) decodeSecret(name string) (*corev1.Secret, *rsa.PrivateKey
 	return secret, key, pemToCertificates(certBytes), nil
 }
 
-func (c *certRenewer) decodeCASecret() (*corev1.Secret, *rsa.PrivateKey, []*x509.Certificate, error) {
-	return c.decodeSecret(GenerateRootCASecretName())
+func (c *certRenewer) decodeCASecret() (*corev1.Secret, crypto.Signer, []*x509.Certificate, error) {
+	if c.external {
+		return c.decodeSecret(GenerateRootCASecretName(), caKey, corev1.TLSCertKey)
+	}
+	return c.decodeSecret(GenerateRootCASecretName(), corev1.TLSCertKey, rootCAKey)
 }
 
-func (c *certRenewer) decodeTLSSecret() (*corev1.Secret, *rsa.PrivateKey, *x509.Certificate, error) {
-	secret, key, certs, err := c.decodeSecret(GenerateTLSPairSecretName())
+// decodeTLSSecret returns the leaf certificate, externally managed secrets may contain the full chain
+func (c *certRenewer) decodeTLSSecret() (*corev1.Secret, crypto.Signer, *x509.Certificate, error) {
+	secret, key, certs, err := c.decodeSecret(GenerateTLSPairSecretName(), corev1.TLSCertKey)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	if len(certs) == 0 {
 		return secret, key, nil, nil
-	} else if len(certs) == 1 {
-		return secret, key, certs[0], nil
-	} else {
-		return nil, nil, nil, err
 	}
+	return secret, key, certs[0], nil
 }
 
-func (c *certRenewer) writeSecret(ctx context.Context, name string, key *rsa.PrivateKey, certs ...*x509.Certificate) error {
+func (c *certRenewer) writeSecret(ctx context.Context, name string, key crypto.Signer, certs ...*x509.Certificate) error {
 	logger := logger.WithValues("name", name, "namespace", config.KyvernoNamespace())
 	secret, err := c.getSecret(name)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -238,10 +299,15 @@ func (c *certRenewer) writeSecret(ctx context.Context, name string, key *rsa.Pri
 			Type: corev1.SecretTypeTLS,
 		}
 	}
+	keyBytes, err := privateKeyToPem(key)
+	if err != nil {
+		logger.Error(err, "failed to encode private key")
+		return err
+	}
 	secret.Type = corev1.SecretTypeTLS
 	secret.Data = map[string][]byte{
 		corev1.TLSCertKey:       certificateToPem(certs...),
-		corev1.TLSPrivateKeyKey: privateKeyToPem(key),
+		corev1.TLSPrivateKeyKey: keyBytes,
 	}
 	if secret.ResourceVersion == "" {
 		if _, err := c.client.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
@@ -262,11 +328,11 @@ func (c *certRenewer) writeSecret(ctx context.Context, name string, key *rsa.Pri
 }
 
 // writeCASecret stores the CA cert in secret
-func (c *certRenewer) writeCASecret(ctx context.Context, key *rsa.PrivateKey, certs ...*x509.Certificate) error {
+func (c *certRenewer) writeCASecret(ctx context.Context, key crypto.Signer, certs ...*x509.Certificate) error {
 	return c.writeSecret(ctx, GenerateRootCASecretName(), key, certs...)
 }
 
 // writeTLSSecret Writes the pair of TLS certificate and key to the specified secret.
-func (c *certRenewer) writeTLSSecret(ctx context.Context, key *rsa.PrivateKey, cert *x509.Certificate) error {
+func (c *certRenewer) writeTLSSecret(ctx context.Context, key crypto.Signer, cert *x509.Certificate) error {
 	return c.writeSecret(ctx, GenerateTLSPairSecretName(), key, cert)
 }
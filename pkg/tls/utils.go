@@ -1,9 +1,12 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
+	"fmt"
 	"time"
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
@@ -11,12 +14,24 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-func privateKeyToPem(rsaKey *rsa.PrivateKey) []byte {
+// privateKeyToPem encodes the private key, RSA keys are encoded in PKCS #1 form for backward compatibility,
+// other keys are encoded in PKCS #8 form
+func privateKeyToPem(key crypto.Signer) ([]byte, error) {
+	var der []byte
+	if rsaKey, ok := key.(*rsa.PrivateKey); ok {
+		der = x509.MarshalPKCS1PrivateKey(rsaKey)
+	} else {
+		bytes, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		der = bytes
+	}
 	privateKey := &pem.Block{
 		Type:  "PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+		Bytes: der,
 	}
-	return pem.EncodeToMemory(privateKey)
+	return pem.EncodeToMemory(privateKey), nil
 }
 
 func certificateToPem(certs ...*x509.Certificate) []byte {
@@ -31,9 +46,22 @@ func certificateToPem(certs ...*x509.Certificate) []byte {
 	return raw
 }
 
-func pemToPrivateKey(raw []byte) (*rsa.PrivateKey, error) {
+// pemToPrivateKey decodes a PKCS #1, PKCS #8 or SEC 1 encoded private key
+func pemToPrivateKey(raw []byte) (crypto.Signer, error) {
 	block, _ := pem.Decode(raw)
-	return x509.ParsePKCS1PrivateKey(block.Bytes)
+	if block == nil {
+		return nil, errors.New("failed to decode private key PEM block")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
 }
 
 func pemToCertificates(raw []byte) []*x509.Certificate {
@@ -101,10 +129,24 @@ func inClusterServiceName() string {
 	return config.KyvernoServiceName() + "." + config.KyvernoNamespace() + ".svc"
 }
 
+// IsExternallyManaged returns true if certificates are managed outside of Kyverno (by cert-manager for example)
+func IsExternallyManaged() bool {
+	return config.KyvernoTLSSecretName() != ""
+}
+
 func GenerateTLSPairSecretName() string {
+	if name := config.KyvernoTLSSecretName(); name != "" {
+		return name
+	}
 	return inClusterServiceName() + ".kyverno-tls-pair"
 }
 
 func GenerateRootCASecretName() string {
+	if IsExternallyManaged() {
+		if name := config.KyvernoCASecretName(); name != "" {
+			return name
+		}
+		return config.KyvernoTLSSecretName()
+	}
 	return inClusterServiceName() + ".kyverno-tls-ca"
 }
@@ -13,6 +13,14 @@ const (
 	PolicyNameKey      = attribute.Key("kyverno.policy.name")
 	PolicyNamespaceKey = attribute.Key("kyverno.policy.namespace")
 	RuleNameKey        = attribute.Key("kyverno.rule.name")
+	// context entry attributes
+	ContextEntryNameKey     = attribute.Key("kyverno.context.entry.name")
+	ContextEntryTypeKey     = attribute.Key("kyverno.context.entry.type")
+	ContextEntryURLKey      = attribute.Key("kyverno.context.entry.url")
+	ContextEntryCacheHitKey = attribute.Key("kyverno.context.entry.cache.hit")
+	// image verification attributes
+	ImageReferenceKey = attribute.Key("kyverno.image.reference")
+	ImageVerifierKey  = attribute.Key("kyverno.image.verifier")
 	// admission resource attributes
 	// ResourceNameKey       = attribute.Key("admission.resource.name")
 	// ResourceNamespaceKey  = attribute.Key("admission.resource.namespace")
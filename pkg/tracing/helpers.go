@@ -3,6 +3,7 @@ package tracing
 import (
 	"context"
 	"net/http"
+	"net/url"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -48,3 +49,18 @@ func CurrentSpan(ctx context.Context) trace.Span {
 func SetAttributes(ctx context.Context, kv ...attribute.KeyValue) {
 	CurrentSpan(ctx).SetAttributes(kv...)
 }
+
+// SanitizeURL removes the user info, query and fragment from the input url so that it can be recorded in a span.
+// Values that can not be parsed are dropped.
+func SanitizeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.ForceQuery = false
+	u.Fragment = ""
+	u.RawFragment = ""
+	return StringValue(u.String())
+}
@@ -116,6 +116,12 @@ func EngineResponseToReportResults(response engineapi.EngineResponse) []policyre
 				}
 			}
 		}
+		if ruleResult.IsTimeout() {
+			if result.Properties == nil {
+				result.Properties = map[string]string{}
+			}
+			result.Properties["timeout"] = "true"
+		}
 		if result.Result == "fail" && !result.Scored {
 			result.Result = "warn"
 		}
@@ -1272,6 +1272,69 @@ func Test_patchesJson6902_Policy(t *testing.T) {
 	assert.NilError(t, err)
 }
 
+func Test_PatchJSONMerge_Expressions_Policy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mutate  string
+		wantErr bool
+	}{{
+		name:   "json merge patch",
+		mutate: `{"patchJsonMerge": {"metadata": {"labels": {"team": "platform"}}}}`,
+	}, {
+		name:   "expressions",
+		mutate: `{"expressions": [{"path": "/metadata/labels/team", "cel": "object.metadata.name"}, {"path": "/metadata/labels/app", "jmesPath": "request.object.metadata.name"}]}`,
+	}, {
+		name:   "foreach expressions",
+		mutate: `{"foreach": [{"list": "request.object.spec.containers", "expressions": [{"path": "/spec/containers/{{elementIndex}}/imagePullPolicy", "cel": "'Always'"}]}]}`,
+	}, {
+		name:    "json merge patch and strategic merge patch",
+		mutate:  `{"patchJsonMerge": {"metadata": {"labels": {"team": "platform"}}}, "patchStrategicMerge": {"metadata": {"labels": {"team": "platform"}}}}`,
+		wantErr: true,
+	}, {
+		name:    "expression with both jmesPath and cel",
+		mutate:  `{"expressions": [{"path": "/metadata/labels/team", "cel": "object.metadata.name", "jmesPath": "request.object.metadata.name"}]}`,
+		wantErr: true,
+	}, {
+		name:    "expression with invalid path",
+		mutate:  `{"expressions": [{"path": "metadata.labels.team", "cel": "object.metadata.name"}]}`,
+		wantErr: true,
+	}, {
+		name:    "expression with invalid cel",
+		mutate:  `{"expressions": [{"path": "/metadata/labels/team", "cel": "object.metadata.name +"}]}`,
+		wantErr: true,
+	}, {
+		name:    "foreach with json merge patch and expressions",
+		mutate:  `{"foreach": [{"list": "request.object.spec.containers", "patchJsonMerge": {"metadata": {"labels": {"team": "platform"}}}, "expressions": [{"path": "/metadata/labels/team", "cel": "'platform'"}]}]}`,
+		wantErr: true,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rawPolicy := []byte(`{
+				"apiVersion": "kyverno.io/v1",
+				"kind": "ClusterPolicy",
+				"metadata": {"name": "mutate-policy"},
+				"spec": {
+					"background": false,
+					"rules": [{
+						"name": "mutate-pods",
+						"match": {"any": [{"resources": {"kinds": ["Pod"]}}]},
+						"mutate": ` + tc.mutate + `
+					}]
+				}
+			}`)
+			var policy *kyverno.ClusterPolicy
+			assert.NilError(t, json.Unmarshal(rawPolicy, &policy))
+			openApiManager, _ := openapi.NewManager(logr.Discard())
+			_, err := Validate(policy, nil, nil, true, openApiManager, "admin")
+			if tc.wantErr {
+				assert.Assert(t, err != nil)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
+
 func Test_deny_exec(t *testing.T) {
 	var err error
 	rawPolicy := []byte(`{
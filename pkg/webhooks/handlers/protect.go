@@ -9,8 +9,11 @@ import (
 
 	"github.com/go-logr/logr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/background/common"
 	"github.com/kyverno/kyverno/pkg/config"
 	admissionutils "github.com/kyverno/kyverno/pkg/utils/admission"
+	datautils "github.com/kyverno/kyverno/pkg/utils/data"
+	"github.com/kyverno/kyverno/pkg/utils/wildcard"
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -19,33 +22,136 @@ const namespaceControllerUsername = "system:serviceaccount:kube-system:namespace
 
 var kyvernoUsernamePrefix = fmt.Sprintf("system:serviceaccount:%s:", config.KyvernoNamespace())
 
-func (inner AdmissionHandler) WithProtection(enabled bool) AdmissionHandler {
+// generateLabelPrefix is the prefix of the labels set by Kyverno on generated resources
+const generateLabelPrefix = "generate.kyverno.io/"
+
+// ignoredFields are updated by the API server and are not considered when checking the fields changed by an update
+var ignoredFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+}
+
+func (inner AdmissionHandler) WithProtection(enabled bool, configuration config.Configuration) AdmissionHandler {
 	if !enabled {
 		return inner
 	}
-	return inner.withProtection().WithTrace("PROTECT")
+	return inner.withProtection(configuration).WithTrace("PROTECT")
 }
 
-func (inner AdmissionHandler) withProtection() AdmissionHandler {
+func (inner AdmissionHandler) withProtection(configuration config.Configuration) AdmissionHandler {
 	return func(ctx context.Context, logger logr.Logger, request AdmissionRequest, startTime time.Time) AdmissionResponse {
 		// Allows deletion of namespace containing managed resources
 		if request.Operation == admissionv1.Delete && request.UserInfo.Username == namespaceControllerUsername {
 			return inner(ctx, logger, request, startTime)
 		}
+		if strings.HasPrefix(request.UserInfo.Username, kyvernoUsernamePrefix) {
+			return inner(ctx, logger, request, startTime)
+		}
 		newResource, oldResource, err := admissionutils.ExtractResources(nil, request.AdmissionRequest)
 		if err != nil {
 			logger.Error(err, "failed to extract resources")
 			return admissionutils.Response(request.UID, err)
 		}
-		for _, resource := range []unstructured.Unstructured{newResource, oldResource} {
-			resLabels := resource.GetLabels()
-			if resLabels[kyvernov1.LabelAppManagedBy] == kyvernov1.ValueKyvernoApp {
-				if !strings.HasPrefix(request.UserInfo.Username, kyvernoUsernamePrefix) {
-					logger.V(2).Info("access to the resource not authorized, this is a kyverno managed resource and should be altered only by kyverno")
-					return admissionutils.Response(request.UID, errors.New("A kyverno managed resource can only be modified by kyverno"))
-				}
+		// the labels of the existing resource are trusted over the labels in the request
+		for _, resource := range []unstructured.Unstructured{oldResource, newResource} {
+			if resource.GetLabels()[kyvernov1.LabelAppManagedBy] != kyvernov1.ValueKyvernoApp {
+				continue
 			}
+			if rule, ok := findAllowRule(configuration.GetManagedResourcesProtection(), request, generatingPolicy(resource), oldResource, newResource); ok {
+				logger.V(4).Info("access to the managed resource allowed by protection rule", "rule", rule)
+				return inner(ctx, logger, request, startTime)
+			}
+			logger.V(2).Info("access to the resource not authorized, this is a kyverno managed resource and should be altered only by kyverno")
+			return admissionutils.Response(request.UID, errors.New("A kyverno managed resource can only be modified by kyverno"))
 		}
 		return inner(ctx, logger, request, startTime)
 	}
 }
+
+// generatingPolicy returns the key of the policy that generated the resource, empty if the resource was not generated
+func generatingPolicy(resource unstructured.Unstructured) string {
+	labels := resource.GetLabels()
+	name := labels[common.GeneratePolicyLabel]
+	if name == "" {
+		return ""
+	}
+	if namespace := labels[common.GeneratePolicyNamespaceLabel]; namespace != "" {
+		return namespace + "/" + name
+	}
+	return name
+}
+
+// findAllowRule returns the index of the first rule allowing the request
+func findAllowRule(protection config.ProtectionConfig, request AdmissionRequest, policy string, oldResource, newResource unstructured.Unstructured) (int, bool) {
+	for i, rule := range protection.Allow {
+		if !matchesSubject(rule, request.UserInfo.Username, request.UserInfo.Groups) {
+			continue
+		}
+		if len(rule.Operations) > 0 && !datautils.SliceContains(rule.Operations, string(request.Operation)) {
+			continue
+		}
+		if len(rule.Policies) > 0 && !datautils.SliceContains(rule.Policies, policy) {
+			continue
+		}
+		if len(rule.Fields) > 0 {
+			if request.Operation != admissionv1.Update || !changesOnly(oldResource, newResource, rule.Fields) {
+				continue
+			}
+		}
+		return i, true
+	}
+	return -1, false
+}
+
+func matchesSubject(rule config.ProtectionAllowRule, username string, groups []string) bool {
+	for _, pattern := range rule.Usernames {
+		if wildcard.Match(pattern, username) {
+			return true
+		}
+	}
+	for _, pattern := range rule.ServiceAccounts {
+		if wildcard.Match("system:serviceaccount:"+pattern, username) {
+			return true
+		}
+	}
+	for _, pattern := range rule.Groups {
+		for _, group := range groups {
+			if wildcard.Match(pattern, group) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// changesOnly returns true if the differences between the old and new resources are limited to the given fields.
+// Labels owned by Kyverno can't be changed, even if the labels are part of the given fields, otherwise
+// removing them would make the resource unmanaged and bypass the protection for subsequent requests.
+func changesOnly(oldResource, newResource unstructured.Unstructured, fields []string) bool {
+	if !datautils.DeepEqual(kyvernoLabels(oldResource), kyvernoLabels(newResource)) {
+		return false
+	}
+	oldObject, newObject := oldResource.DeepCopy(), newResource.DeepCopy()
+	for _, field := range ignoredFields {
+		unstructured.RemoveNestedField(oldObject.Object, field...)
+		unstructured.RemoveNestedField(newObject.Object, field...)
+	}
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		unstructured.RemoveNestedField(oldObject.Object, path...)
+		unstructured.RemoveNestedField(newObject.Object, path...)
+	}
+	return datautils.DeepEqual(oldObject.Object, newObject.Object)
+}
+
+// kyvernoLabels returns the labels owned by Kyverno
+func kyvernoLabels(resource unstructured.Unstructured) map[string]string {
+	labels := map[string]string{}
+	for key, value := range resource.GetLabels() {
+		if key == kyvernov1.LabelAppManagedBy || strings.HasPrefix(key, generateLabelPrefix) {
+			labels[key] = value
+		}
+	}
+	return labels
+}
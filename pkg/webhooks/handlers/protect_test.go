@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kyverno/kyverno/pkg/config"
+	admissionutils "github.com/kyverno/kyverno/pkg/utils/admission"
+	"gotest.tools/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newConfigMap(labels map[string]interface{}, data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "config",
+			"namespace":       "default",
+			"resourceVersion": "1",
+			"labels":          labels,
+		},
+		"data": data,
+	}
+}
+
+func managedLabels(policy string) map[string]interface{} {
+	return map[string]interface{}{
+		"app.kubernetes.io/managed-by":    "kyverno",
+		"generate.kyverno.io/policy-name": policy,
+	}
+}
+
+func Test_withProtection(t *testing.T) {
+	protection := `{"allow": [
+		{"serviceAccounts": ["argocd:*"], "policies": ["sync-configmaps"]},
+		{"groups": ["break-glass"], "operations": ["UPDATE"], "fields": ["metadata.labels", "metadata.annotations"]}
+	]}`
+	tests := []struct {
+		name      string
+		operation admissionv1.Operation
+		username  string
+		groups    []string
+		object    map[string]interface{}
+		oldObject map[string]interface{}
+		want      bool
+	}{{
+		name:      "unmanaged resource",
+		operation: admissionv1.Update,
+		username:  "alice",
+		object:    newConfigMap(nil, map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(nil, map[string]interface{}{"a": "a"}),
+		want:      true,
+	}, {
+		name:      "managed resource",
+		operation: admissionv1.Update,
+		username:  "alice",
+		object:    newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "kyverno",
+		operation: admissionv1.Update,
+		username:  "system:serviceaccount:kyverno:kyverno-background-controller",
+		object:    newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+		want:      true,
+	}, {
+		name:      "allowed service account",
+		operation: admissionv1.Update,
+		username:  "system:serviceaccount:argocd:argocd-application-controller",
+		object:    newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+		want:      true,
+	}, {
+		name:      "allowed service account on other policy",
+		operation: admissionv1.Update,
+		username:  "system:serviceaccount:argocd:argocd-application-controller",
+		object:    newConfigMap(managedLabels("other"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("other"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "allowed service account changing the policy label",
+		operation: admissionv1.Update,
+		username:  "system:serviceaccount:argocd:argocd-application-controller",
+		object:    newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("other"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "allowed group changing labels",
+		operation: admissionv1.Update,
+		username:  "bob",
+		groups:    []string{"system:authenticated", "break-glass"},
+		object:    newConfigMap(map[string]interface{}{"app.kubernetes.io/managed-by": "kyverno", "generate.kyverno.io/policy-name": "sync-configmaps", "team": "a"}, map[string]interface{}{"a": "a"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+		want:      true,
+	}, {
+		name:      "allowed group removing the managed by label",
+		operation: admissionv1.Update,
+		username:  "bob",
+		groups:    []string{"break-glass"},
+		object:    newConfigMap(map[string]interface{}{"generate.kyverno.io/policy-name": "sync-configmaps"}, map[string]interface{}{"a": "a"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "allowed group changing a generate label",
+		operation: admissionv1.Update,
+		username:  "bob",
+		groups:    []string{"break-glass"},
+		object:    newConfigMap(managedLabels("other"), map[string]interface{}{"a": "a"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "allowed group changing data",
+		operation: admissionv1.Update,
+		username:  "bob",
+		groups:    []string{"break-glass"},
+		object:    newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "b"}),
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "allowed group deleting",
+		operation: admissionv1.Delete,
+		username:  "bob",
+		groups:    []string{"break-glass"},
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+	}, {
+		name:      "namespace controller",
+		operation: admissionv1.Delete,
+		username:  "system:serviceaccount:kube-system:namespace-controller",
+		oldObject: newConfigMap(managedLabels("sync-configmaps"), map[string]interface{}{"a": "a"}),
+		want:      true,
+	}}
+	configuration := config.NewDefaultConfiguration(false)
+	configuration.Load(&corev1.ConfigMap{Data: map[string]string{"managedResourcesProtection": protection}})
+	inner := AdmissionHandler(func(_ context.Context, _ logr.Logger, request AdmissionRequest, _ time.Time) AdmissionResponse {
+		return admissionutils.ResponseSuccess(request.UID)
+	})
+	handler := inner.withProtection(configuration)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := AdmissionRequest{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tt.operation,
+					UserInfo: authenticationv1.UserInfo{
+						Username: tt.username,
+						Groups:   tt.groups,
+					},
+				},
+			}
+			if tt.object != nil {
+				raw, err := json.Marshal(tt.object)
+				assert.NilError(t, err)
+				request.Object = runtime.RawExtension{Raw: raw}
+			}
+			if tt.oldObject != nil {
+				raw, err := json.Marshal(tt.oldObject)
+				assert.NilError(t, err)
+				request.OldObject = runtime.RawExtension{Raw: raw}
+			}
+			response := handler(context.TODO(), logr.Discard(), request, time.Now())
+			assert.Equal(t, tt.want, response.Allowed)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package webhooks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kyverno/kyverno/pkg/config"
+)
+
+const (
+	DefaultServerPort              = 9443
+	DefaultServerReadTimeout       = 30 * time.Second
+	DefaultServerWriteTimeout      = 30 * time.Second
+	DefaultServerReadHeaderTimeout = 30 * time.Second
+	DefaultServerIdleTimeout       = 5 * time.Minute
+)
+
+// ServerOptions holds the options to configure the webhook server listener
+type ServerOptions struct {
+	// Address is the address the server binds to, the server listens on all interfaces if empty
+	Address string
+	// Port is the port the server listens on
+	Port int
+	// ClientCAs is used to verify the client certificate presented by the API server, client certificates are not required if nil
+	ClientCAs *x509.CertPool
+	// ClientCertificateExemptPaths are the paths served without client certificate when ClientCAs is set,
+	// liveness and readiness paths are always exempt
+	ClientCertificateExemptPaths []string
+	// ReadTimeout is the maximum duration for reading the entire request
+	ReadTimeout time.Duration
+	// WriteTimeout is the maximum duration before timing out writes of the response
+	WriteTimeout time.Duration
+	// ReadHeaderTimeout is the amount of time allowed to read request headers
+	ReadHeaderTimeout time.Duration
+	// IdleTimeout is the maximum amount of time to wait for the next request when keep-alives are enabled
+	IdleTimeout time.Duration
+	// MaxHeaderBytes is the maximum number of bytes the server will read parsing the request headers
+	MaxHeaderBytes int
+	// DisableHTTP2 restricts the server to HTTP/1.1
+	DisableHTTP2 bool
+}
+
+// DefaultServerOptions returns the default server options
+func DefaultServerOptions() ServerOptions {
+	return ServerOptions{
+		Port:              DefaultServerPort,
+		ReadTimeout:       DefaultServerReadTimeout,
+		WriteTimeout:      DefaultServerWriteTimeout,
+		ReadHeaderTimeout: DefaultServerReadHeaderTimeout,
+		IdleTimeout:       DefaultServerIdleTimeout,
+		MaxHeaderBytes:    http.DefaultMaxHeaderBytes,
+	}
+}
+
+// NewHttpServer creates a TLS server configured according to the given options
+func NewHttpServer(options ServerOptions, handler http.Handler, tlsProvider TlsProvider, errorLog *log.Logger) *http.Server {
+	tlsConfig := &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return tlsProvider()
+		},
+		MinVersion: tls.VersionTLS12,
+		CipherSuites: []uint16{
+			// AEADs w/ ECDHE
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+			tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+		},
+	}
+	if options.ClientCAs != nil {
+		// probes are sent by the kubelet without client certificate, the certificate is verified
+		// during the handshake when presented and required by the handler for other paths
+		tlsConfig.ClientCAs = options.ClientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		exemptPaths := append([]string{config.LivenessServicePath, config.ReadinessServicePath}, options.ClientCertificateExemptPaths...)
+		handler = withClientCertificate(handler, exemptPaths...)
+	}
+	server := &http.Server{
+		Addr:              net.JoinHostPort(options.Address, strconv.Itoa(options.Port)),
+		TLSConfig:         tlsConfig,
+		Handler:           handler,
+		ReadTimeout:       options.ReadTimeout,
+		WriteTimeout:      options.WriteTimeout,
+		ReadHeaderTimeout: options.ReadHeaderTimeout,
+		IdleTimeout:       options.IdleTimeout,
+		MaxHeaderBytes:    options.MaxHeaderBytes,
+		ErrorLog:          errorLog,
+	}
+	if options.DisableHTTP2 {
+		// a non nil empty map disables the automatic HTTP/2 upgrade
+		tlsConfig.NextProtos = []string{"http/1.1"}
+		server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+	}
+	return server
+}
+
+// withClientCertificate rejects requests without a verified client certificate, except for the given paths
+func withClientCertificate(inner http.Handler, exemptPaths ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, path := range exemptPaths {
+			if r.URL.Path == path {
+				inner.ServeHTTP(w, r)
+				return
+			}
+		}
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+			http.Error(w, "a valid client certificate is required", http.StatusUnauthorized)
+			return
+		}
+		inner.ServeHTTP(w, r)
+	})
+}
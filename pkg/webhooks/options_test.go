@@ -0,0 +1,92 @@
+package webhooks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	"gotest.tools/assert"
+)
+
+func TestNewHttpServer(t *testing.T) {
+	handler := http.NotFoundHandler()
+	server := NewHttpServer(DefaultServerOptions(), handler, nil, nil)
+	assert.Equal(t, ":9443", server.Addr)
+	assert.Equal(t, http.DefaultMaxHeaderBytes, server.MaxHeaderBytes)
+	assert.Equal(t, tls.NoClientCert, server.TLSConfig.ClientAuth)
+	assert.Assert(t, server.TLSNextProto == nil)
+
+	options := DefaultServerOptions()
+	options.Address = "127.0.0.1"
+	options.Port = 10250
+	options.DisableHTTP2 = true
+	options.ClientCAs = x509.NewCertPool()
+	server = NewHttpServer(options, handler, nil, nil)
+	assert.Equal(t, "127.0.0.1:10250", server.Addr)
+	assert.Equal(t, tls.VerifyClientCertIfGiven, server.TLSConfig.ClientAuth)
+	assert.Assert(t, server.TLSNextProto != nil && len(server.TLSNextProto) == 0)
+	assert.DeepEqual(t, []string{"http/1.1"}, server.TLSConfig.NextProtos)
+}
+
+func TestNewHttpServer_clientCertificateExemptPaths(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	options := DefaultServerOptions()
+	options.ClientCAs = x509.NewCertPool()
+	options.ClientCertificateExemptPaths = []string{"/cleanup"}
+	server := NewHttpServer(options, handler, nil, nil)
+	for path, want := range map[string]int{
+		"/cleanup":                          http.StatusOK,
+		config.LivenessServicePath:          http.StatusOK,
+		config.ReadinessServicePath:         http.StatusOK,
+		config.ValidatingWebhookServicePath: http.StatusUnauthorized,
+	} {
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		recorder := httptest.NewRecorder()
+		server.Handler.ServeHTTP(recorder, request)
+		assert.Equal(t, want, recorder.Code, path)
+	}
+}
+
+func Test_withClientCertificate(t *testing.T) {
+	handler := withClientCertificate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), config.LivenessServicePath)
+	tests := []struct {
+		name  string
+		path  string
+		state *tls.ConnectionState
+		want  int
+	}{{
+		name: "exempt path",
+		path: config.LivenessServicePath,
+		want: http.StatusOK,
+	}, {
+		name: "no tls",
+		path: config.ValidatingWebhookServicePath,
+		want: http.StatusUnauthorized,
+	}, {
+		name:  "no client certificate",
+		path:  config.ValidatingWebhookServicePath,
+		state: &tls.ConnectionState{},
+		want:  http.StatusUnauthorized,
+	}, {
+		name:  "verified client certificate",
+		path:  config.ValidatingWebhookServicePath,
+		state: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}},
+		want:  http.StatusOK,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			request.TLS = tt.state
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			assert.Equal(t, tt.want, recorder.Code)
+		})
+	}
+}
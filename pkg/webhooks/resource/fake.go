@@ -6,6 +6,7 @@ import (
 	fakekyvernov1 "github.com/kyverno/kyverno/pkg/client/clientset/versioned/fake"
 	kyvernoinformers "github.com/kyverno/kyverno/pkg/client/informers/externalversions"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
@@ -52,6 +53,7 @@ func NewFakeHandlers(ctx context.Context, policyCache policycache.Cache) webhook
 		urLister:       urLister,
 		urGenerator:    updaterequest.NewFake(),
 		eventGen:       event.NewFake(),
+		publisher:      cloudevents.NewFake(),
 		openApiManager: openapi.NewFake(),
 		pcBuilder:      webhookutils.NewPolicyContextBuilder(configuration, jp),
 		engine: engine.NewEngine(
@@ -60,8 +62,9 @@ func NewFakeHandlers(ctx context.Context, policyCache policycache.Cache) webhook
 			jp,
 			dclient,
 			rclient,
-			engineapi.DefaultContextLoaderFactory(configMapResolver),
+			engineapi.DefaultContextLoaderFactory(configMapResolver, metricsConfig),
 			peLister,
+			1,
 		),
 	}
 }
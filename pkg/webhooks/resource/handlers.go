@@ -12,6 +12,7 @@ import (
 	kyvernov1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1"
 	kyvernov1beta1listers "github.com/kyverno/kyverno/pkg/client/listers/kyverno/v1beta1"
 	"github.com/kyverno/kyverno/pkg/clients/dclient"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	"github.com/kyverno/kyverno/pkg/engine/jmespath"
@@ -56,11 +57,13 @@ type resourceHandlers struct {
 
 	urGenerator    webhookgenerate.Generator
 	eventGen       event.Interface
+	publisher      cloudevents.Interface
 	openApiManager openapi.ValidateInterface
 	pcBuilder      webhookutils.PolicyContextBuilder
 
 	admissionReports             bool
 	backgroungServiceAccountName string
+	validationWorkers            int
 }
 
 func NewHandlers(
@@ -77,10 +80,12 @@ func NewHandlers(
 	polInformer kyvernov1informers.PolicyInformer,
 	urGenerator webhookgenerate.Generator,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	openApiManager openapi.ValidateInterface,
 	admissionReports bool,
 	backgroungServiceAccountName string,
 	jp jmespath.Interface,
+	validationWorkers int,
 ) webhooks.ResourceHandlers {
 	return &resourceHandlers{
 		engine:                       engine,
@@ -96,10 +101,12 @@ func NewHandlers(
 		polLister:                    polInformer.Lister(),
 		urGenerator:                  urGenerator,
 		eventGen:                     eventGen,
+		publisher:                    publisher,
 		openApiManager:               openApiManager,
 		pcBuilder:                    webhookutils.NewPolicyContextBuilder(configuration, jp),
 		admissionReports:             admissionReports,
 		backgroungServiceAccountName: backgroungServiceAccountName,
+		validationWorkers:            validationWorkers,
 	}
 }
 
@@ -107,13 +114,22 @@ func (h *resourceHandlers) Validate(ctx context.Context, logger logr.Logger, req
 	kind := request.Kind.Kind
 	logger = logger.WithValues("kind", kind)
 	logger.V(4).Info("received an admission request in validating webhook")
+	if webhookutils.IsExplainRequested(h.configuration, request.AdmissionRequest) {
+		ctx = engineapi.WithExplain(ctx)
+	}
+
+	namespaceLabels := make(map[string]string)
+	if request.Kind.Kind != "Namespace" && request.Namespace != "" {
+		namespaceLabels = engineutils.GetNamespaceSelectorsFromNamespaceLister(request.Kind.Kind, request.Namespace, h.nsLister, logger)
+	}
 
 	// timestamp at which this admission request got triggered
 	gvr := schema.GroupVersionResource(request.Resource)
-	policies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.ValidateEnforce, gvr, request.SubResource, request.Namespace)...)
+	policies := filterPolicies(failurePolicy, h.getCandidates(logger, policycache.ValidateEnforce, request, namespaceLabels)...)
+	// mutate existing and generate policies are processed in the background, they are not pre-filtered
 	mutatePolicies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.Mutate, gvr, request.SubResource, request.Namespace)...)
 	generatePolicies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.Generate, gvr, request.SubResource, request.Namespace)...)
-	imageVerifyValidatePolicies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.VerifyImagesValidate, gvr, request.SubResource, request.Namespace)...)
+	imageVerifyValidatePolicies := filterPolicies(failurePolicy, h.getCandidates(logger, policycache.VerifyImagesValidate, request, namespaceLabels)...)
 	policies = append(policies, imageVerifyValidatePolicies...)
 
 	if len(policies) == 0 && len(mutatePolicies) == 0 && len(generatePolicies) == 0 {
@@ -127,12 +143,8 @@ func (h *resourceHandlers) Validate(ctx context.Context, logger logr.Logger, req
 		return errorResponse(logger, request.UID, err, "failed create policy context")
 	}
 
-	namespaceLabels := make(map[string]string)
-	if request.Kind.Kind != "Namespace" && request.Namespace != "" {
-		namespaceLabels = engineutils.GetNamespaceSelectorsFromNamespaceLister(request.Kind.Kind, request.Namespace, h.nsLister, logger)
-	}
 	policyContext = policyContext.WithNamespaceLabels(namespaceLabels)
-	vh := validation.NewValidationHandler(logger, h.kyvernoClient, h.engine, h.pCache, h.pcBuilder, h.eventGen, h.admissionReports, h.metricsConfig, h.configuration)
+	vh := validation.NewValidationHandler(logger, h.kyvernoClient, h.engine, h.pCache, h.pcBuilder, h.eventGen, h.publisher, h.admissionReports, h.metricsConfig, h.configuration, h.validationWorkers)
 
 	ok, msg, warnings := vh.HandleValidation(ctx, request, policies, policyContext, startTime)
 	if !ok {
@@ -149,9 +161,15 @@ func (h *resourceHandlers) Mutate(ctx context.Context, logger logr.Logger, reque
 	kind := request.Kind.Kind
 	logger = logger.WithValues("kind", kind)
 	logger.V(4).Info("received an admission request in mutating webhook")
-	gvr := schema.GroupVersionResource(request.Resource)
-	mutatePolicies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.Mutate, gvr, request.SubResource, request.Namespace)...)
-	verifyImagesPolicies := filterPolicies(failurePolicy, h.pCache.GetPolicies(policycache.VerifyImagesMutate, gvr, request.SubResource, request.Namespace)...)
+	if webhookutils.IsExplainRequested(h.configuration, request.AdmissionRequest) {
+		ctx = engineapi.WithExplain(ctx)
+	}
+	namespaceLabels := make(map[string]string)
+	if request.Kind.Kind != "Namespace" && request.Namespace != "" {
+		namespaceLabels = engineutils.GetNamespaceSelectorsFromNamespaceLister(request.Kind.Kind, request.Namespace, h.nsLister, logger)
+	}
+	mutatePolicies := filterPolicies(failurePolicy, h.getCandidates(logger, policycache.Mutate, request, namespaceLabels)...)
+	verifyImagesPolicies := filterPolicies(failurePolicy, h.getCandidates(logger, policycache.VerifyImagesMutate, request, namespaceLabels)...)
 	if len(mutatePolicies) == 0 && len(verifyImagesPolicies) == 0 {
 		logger.V(4).Info("no policies matched mutate admission request")
 		return admissionutils.ResponseSuccess(request.UID)
@@ -162,7 +180,7 @@ func (h *resourceHandlers) Mutate(ctx context.Context, logger logr.Logger, reque
 		logger.Error(err, "failed to build policy context")
 		return admissionutils.Response(request.UID, err)
 	}
-	mh := mutation.NewMutationHandler(logger, h.engine, h.eventGen, h.openApiManager, h.nsLister, h.metricsConfig)
+	mh := mutation.NewMutationHandler(logger, h.engine, h.eventGen, h.publisher, h.openApiManager, h.nsLister, h.metricsConfig, h.configuration)
 	mutatePatches, mutateWarnings, err := mh.HandleMutation(ctx, request.AdmissionRequest, mutatePolicies, policyContext, startTime)
 	if err != nil {
 		logger.Error(err, "mutation failed")
@@ -175,7 +193,7 @@ func (h *resourceHandlers) Mutate(ctx context.Context, logger logr.Logger, reque
 		logger.Error(err, "failed to build policy context")
 		return admissionutils.Response(request.UID, err)
 	}
-	ivh := imageverification.NewImageVerificationHandler(logger, h.kyvernoClient, h.engine, h.eventGen, h.admissionReports, h.configuration, h.nsLister)
+	ivh := imageverification.NewImageVerificationHandler(logger, h.kyvernoClient, h.engine, h.eventGen, h.publisher, h.admissionReports, h.configuration, h.nsLister)
 	imagePatches, imageVerifyWarnings, err := ivh.Handle(ctx, newRequest, verifyImagesPolicies, policyContext)
 	if err != nil {
 		logger.Error(err, "image verification failed")
@@ -188,6 +206,21 @@ func (h *resourceHandlers) Mutate(ctx context.Context, logger logr.Logger, reque
 	return admissionutils.MutationResponse(request.UID, patch, warnings...)
 }
 
+// getCandidates returns the policies that may apply to the request, pre-filtered by the policy cache
+func (h *resourceHandlers) getCandidates(logger logr.Logger, pkey policycache.PolicyType, request handlers.AdmissionRequest, namespaceLabels map[string]string) []kyvernov1.PolicyInterface {
+	gvr := schema.GroupVersionResource(request.Resource)
+	candidates := h.pCache.GetCandidates(pkey, gvr, request.SubResource, request.Namespace, policycache.MatchRequest{
+		Operation:       kyvernov1.AdmissionOperation(request.Operation),
+		NamespaceLabels: namespaceLabels,
+	})
+	var policies []kyvernov1.PolicyInterface
+	for _, candidate := range candidates {
+		logger.V(4).Info("policy selected", "policy", candidate.Policy.GetName(), "reasons", candidate.Reasons)
+		policies = append(policies, candidate.Policy)
+	}
+	return policies
+}
+
 func filterPolicies(failurePolicy string, policies ...kyvernov1.PolicyInterface) []kyvernov1.PolicyInterface {
 	var results []kyvernov1.PolicyInterface
 	for _, policy := range policies {
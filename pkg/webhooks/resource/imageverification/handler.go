@@ -8,6 +8,7 @@ import (
 	"github.com/go-logr/logr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
@@ -36,6 +37,7 @@ type imageVerificationHandler struct {
 	engine           engineapi.Engine
 	log              logr.Logger
 	eventGen         event.Interface
+	publisher        cloudevents.Interface
 	admissionReports bool
 	cfg              config.Configuration
 	nsLister         corev1listers.NamespaceLister
@@ -46,6 +48,7 @@ func NewImageVerificationHandler(
 	kyvernoClient versioned.Interface,
 	engine engineapi.Engine,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	admissionReports bool,
 	cfg config.Configuration,
 	nsLister corev1listers.NamespaceLister,
@@ -55,6 +58,7 @@ func NewImageVerificationHandler(
 		engine:           engine,
 		log:              log,
 		eventGen:         eventGen,
+		publisher:        publisher,
 		admissionReports: admissionReports,
 		cfg:              cfg,
 		nsLister:         nsLister,
@@ -119,6 +123,7 @@ func (h *imageVerificationHandler) handleVerifyImages(
 	blocked := webhookutils.BlockRequest(engineResponses, failurePolicy, logger)
 	events := webhookutils.GenerateEvents(engineResponses, blocked)
 	h.eventGen.Add(events...)
+	h.publisher.Publish(event.AdmissionController, blocked, engineResponses...)
 
 	if blocked {
 		logger.V(4).Info("admission request blocked")
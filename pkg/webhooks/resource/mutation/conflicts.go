@@ -0,0 +1,191 @@
+package mutation
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	datautils "github.com/kyverno/kyverno/pkg/utils/data"
+)
+
+// ruleRef identifies a mutation rule
+type ruleRef struct {
+	policy kyvernov1.PolicyInterface
+	rule   string
+}
+
+func (r ruleRef) String() string {
+	if r.policy.GetNamespace() != "" {
+		return fmt.Sprintf("%s/%s/%s", r.policy.GetNamespace(), r.policy.GetName(), r.rule)
+	}
+	return fmt.Sprintf("%s/%s", r.policy.GetName(), r.rule)
+}
+
+// mutationConflict is raised when a rule overrides a value written by another rule during the same admission request
+type mutationConflict struct {
+	path       string
+	overridden ruleRef
+	overriding ruleRef
+}
+
+func (c mutationConflict) String() string {
+	return fmt.Sprintf("mutation conflict on %s: rule %s overrides the value set by rule %s", c.path, c.overriding, c.overridden)
+}
+
+// pathWrite is a value written by a rule
+type pathWrite struct {
+	rule      ruleRef
+	path      []string
+	operation string
+	value     interface{}
+}
+
+// conflictDetector records the JSON paths written by mutation rules and detects rules overriding each other
+type conflictDetector struct {
+	writes    []pathWrite
+	conflicts []mutationConflict
+	seen      map[string]struct{}
+}
+
+func newConflictDetector() *conflictDetector {
+	return &conflictDetector{
+		seen: map[string]struct{}{},
+	}
+}
+
+// add records the patches of the successful rules in the engine response, patches must be added in the order they are applied
+func (d *conflictDetector) add(response engineapi.EngineResponse) {
+	for _, rule := range response.PolicyResponse.Rules {
+		if rule.Status() != engineapi.RuleStatusPass {
+			continue
+		}
+		ref := ruleRef{policy: response.Policy(), rule: rule.Name()}
+		for _, patch := range rule.Patches() {
+			path := parsePointer(patch.Path)
+			// adding an element to an array doesn't override anything
+			if patch.Operation == "add" && isArrayIndex(path) {
+				continue
+			}
+			write := pathWrite{
+				rule:      ref,
+				path:      path,
+				operation: patch.Operation,
+				value:     patch.Value,
+			}
+			for _, previous := range d.writes {
+				if previous.rule.String() != ref.String() && overrides(previous, write) {
+					d.addConflict(mutationConflict{
+						path:       patch.Path,
+						overridden: previous.rule,
+						overriding: ref,
+					})
+				}
+			}
+			d.writes = append(d.writes, write)
+		}
+	}
+}
+
+func (d *conflictDetector) addConflict(conflict mutationConflict) {
+	key := conflict.String()
+	if _, ok := d.seen[key]; ok {
+		return
+	}
+	d.seen[key] = struct{}{}
+	d.conflicts = append(d.conflicts, conflict)
+}
+
+// result returns the detected conflicts sorted by path
+func (d *conflictDetector) result() []mutationConflict {
+	sort.SliceStable(d.conflicts, func(i, j int) bool {
+		return d.conflicts[i].path < d.conflicts[j].path
+	})
+	return d.conflicts
+}
+
+// overrides returns true if the current write changes a value set by the previous write
+func overrides(previous, current pathWrite) bool {
+	switch {
+	case isPrefix(previous.path, current.path):
+		// the current write modifies a value at or below the previous write
+		value, found := lookup(previous.value, current.path[len(previous.path):])
+		if previous.operation == "remove" || !found {
+			return false
+		}
+		return current.operation == "remove" || !datautils.DeepEqual(value, current.value)
+	case isPrefix(current.path, previous.path):
+		// the current write replaces a parent of the previous write
+		value, found := lookup(current.value, previous.path[len(current.path):])
+		if current.operation == "remove" {
+			return previous.operation != "remove"
+		}
+		if previous.operation == "remove" {
+			return found
+		}
+		return !found || !datautils.DeepEqual(value, previous.value)
+	default:
+		return false
+	}
+}
+
+// parsePointer splits a RFC 6901 JSON pointer into unescaped tokens
+func parsePointer(pointer string) []string {
+	if pointer == "" || pointer == "/" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+func isArrayIndex(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	last := path[len(path)-1]
+	if last == "-" {
+		return true
+	}
+	_, err := strconv.Atoi(last)
+	return err == nil
+}
+
+func isPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the value at path in the given document
+func lookup(document interface{}, path []string) (interface{}, bool) {
+	for _, token := range path {
+		switch typed := document.(type) {
+		case map[string]interface{}:
+			value, ok := typed[token]
+			if !ok {
+				return nil, false
+			}
+			document = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			document = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return document, true
+}
@@ -0,0 +1,102 @@
+package mutation
+
+import (
+	"testing"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/mattbaird/jsonpatch"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+)
+
+func newPolicy(name string, order *int32) kyvernov1.PolicyInterface {
+	return &kyvernov1.ClusterPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       kyvernov1.Spec{MutationOrder: order},
+	}
+}
+
+func newResponse(policy kyvernov1.PolicyInterface, rule string, patches ...jsonpatch.JsonPatchOperation) engineapi.EngineResponse {
+	var policyResponse engineapi.PolicyResponse
+	policyResponse.Add(engineapi.ExecutionStats{}, *engineapi.RulePass(rule, engineapi.Mutation, "").WithPatches(patches...))
+	return engineapi.NewEngineResponse(unstructured.Unstructured{}, policy, nil).WithPolicyResponse(policyResponse)
+}
+
+func Test_conflictDetector(t *testing.T) {
+	policyA, policyB := newPolicy("policy-a", nil), newPolicy("policy-b", nil)
+	tests := []struct {
+		name      string
+		responses []engineapi.EngineResponse
+		want      []string
+	}{{
+		name: "different paths",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/metadata/labels/a", "a")),
+			newResponse(policyB, "rule", jsonpatch.NewPatch("add", "/metadata/labels/b", "b")),
+		},
+	}, {
+		name: "same path",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/metadata/labels/team", "a")),
+			newResponse(policyB, "rule", jsonpatch.NewPatch("replace", "/metadata/labels/team", "b")),
+		},
+		want: []string{"mutation conflict on /metadata/labels/team: rule policy-b/rule overrides the value set by rule policy-a/rule"},
+	}, {
+		name: "same rule",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/metadata/labels/team", "a"), jsonpatch.NewPatch("replace", "/metadata/labels/team", "b")),
+		},
+	}, {
+		name: "child of a previous write",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/metadata/labels", map[string]interface{}{"team": "a"})),
+			newResponse(policyB, "rule", jsonpatch.NewPatch("replace", "/metadata/labels/team", "b"), jsonpatch.NewPatch("add", "/metadata/labels/other", "b")),
+		},
+		want: []string{"mutation conflict on /metadata/labels/team: rule policy-b/rule overrides the value set by rule policy-a/rule"},
+	}, {
+		name: "parent of a previous write",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/metadata/labels/team", "a")),
+			newResponse(policyB, "rule", jsonpatch.NewPatch("remove", "/metadata/labels", nil)),
+		},
+		want: []string{"mutation conflict on /metadata/labels: rule policy-b/rule overrides the value set by rule policy-a/rule"},
+	}, {
+		name: "array elements",
+		responses: []engineapi.EngineResponse{
+			newResponse(policyA, "rule", jsonpatch.NewPatch("add", "/spec/containers/1", map[string]interface{}{"name": "a"})),
+			newResponse(policyB, "rule", jsonpatch.NewPatch("add", "/spec/containers/1", map[string]interface{}{"name": "b"})),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := newConflictDetector()
+			for _, response := range tt.responses {
+				detector.add(response)
+			}
+			var got []string
+			for _, conflict := range detector.result() {
+				got = append(got, conflict.String())
+			}
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sortPolicies(t *testing.T) {
+	policies := []kyvernov1.PolicyInterface{
+		newPolicy("a", pointer.Int32(10)),
+		newPolicy("b", nil),
+		newPolicy("c", pointer.Int32(-5)),
+		newPolicy("d", nil),
+	}
+	var names []string
+	for _, policy := range sortPolicies(policies) {
+		names = append(names, policy.GetName())
+	}
+	assert.DeepEqual(t, []string{"c", "b", "d", "a"}, names)
+	// the input is not modified
+	assert.Equal(t, "a", policies[0].GetName())
+}
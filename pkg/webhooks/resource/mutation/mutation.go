@@ -3,10 +3,14 @@ package mutation
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
+	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
 	"github.com/kyverno/kyverno/pkg/engine/mutate/patch"
@@ -19,6 +23,9 @@ import (
 	jsonutils "github.com/kyverno/kyverno/pkg/utils/json"
 	webhookutils "github.com/kyverno/kyverno/pkg/webhooks/utils"
 	"github.com/mattbaird/jsonpatch"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/trace"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
@@ -35,17 +42,30 @@ func NewMutationHandler(
 	log logr.Logger,
 	engine engineapi.Engine,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	openApiManager openapi.ValidateInterface,
 	nsLister corev1listers.NamespaceLister,
-	metrics metrics.MetricsConfigManager,
+	metricsConfig metrics.MetricsConfigManager,
+	configuration config.Configuration,
 ) MutationHandler {
+	meter := global.MeterProvider().Meter(metrics.MeterName)
+	conflictsMetric, err := meter.Int64Counter(
+		"kyverno_mutation_conflicts",
+		metric.WithDescription("can be used to track the number of times a mutation rule overrides a value set by another rule during the same admission request"),
+	)
+	if err != nil {
+		log.Error(err, "Failed to create instrument, kyverno_mutation_conflicts")
+	}
 	return &mutationHandler{
-		log:            log,
-		engine:         engine,
-		eventGen:       eventGen,
-		openApiManager: openApiManager,
-		nsLister:       nsLister,
-		metrics:        metrics,
+		log:             log,
+		engine:          engine,
+		eventGen:        eventGen,
+		publisher:       publisher,
+		openApiManager:  openApiManager,
+		nsLister:        nsLister,
+		metrics:         metricsConfig,
+		configuration:   configuration,
+		conflictsMetric: conflictsMetric,
 	}
 }
 
@@ -53,9 +73,13 @@ type mutationHandler struct {
 	log            logr.Logger
 	engine         engineapi.Engine
 	eventGen       event.Interface
+	publisher      cloudevents.Interface
 	openApiManager openapi.ValidateInterface
 	nsLister       corev1listers.NamespaceLister
 	metrics        metrics.MetricsConfigManager
+	configuration  config.Configuration
+
+	conflictsMetric metric.Int64Counter
 }
 
 func (h *mutationHandler) HandleMutation(
@@ -65,30 +89,36 @@ func (h *mutationHandler) HandleMutation(
 	policyContext *engine.PolicyContext,
 	admissionRequestTimestamp time.Time,
 ) ([]byte, []string, error) {
-	mutatePatches, mutateEngineResponses, err := h.applyMutations(ctx, request, policies, policyContext)
+	mutatePatches, mutateEngineResponses, conflicts, err := h.applyMutations(ctx, request, policies, policyContext)
 	if err != nil {
 		return nil, nil, err
 	}
 	h.log.V(6).Info("", "generated patches", string(mutatePatches))
-	return mutatePatches, webhookutils.GetWarningMessages(mutateEngineResponses), nil
+	warnings := webhookutils.GetWarningMessages(mutateEngineResponses)
+	warnings = append(warnings, webhookutils.GetExplanationWarnings(mutateEngineResponses, h.configuration.GetAdmissionExplainValues())...)
+	for _, conflict := range conflicts {
+		warnings = append(warnings, conflict.String())
+	}
+	return mutatePatches, warnings, nil
 }
 
 // applyMutations handles mutating webhook admission request
-// return value: generated patches, triggered policies, engine responses correspdonding to the triggered policies
+// return value: generated patches, engine responses correspdonding to the triggered policies, conflicts between rules
 func (v *mutationHandler) applyMutations(
 	ctx context.Context,
 	request admissionv1.AdmissionRequest,
 	policies []kyvernov1.PolicyInterface,
 	policyContext *engine.PolicyContext,
-) ([]byte, []engineapi.EngineResponse, error) {
+) ([]byte, []engineapi.EngineResponse, []mutationConflict, error) {
 	if len(policies) == 0 {
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 
 	var patches []jsonpatch.JsonPatchOperation
 	var engineResponses []engineapi.EngineResponse
+	conflictDetector := newConflictDetector()
 
-	for _, policy := range policies {
+	for _, policy := range sortPolicies(policies) {
 		spec := policy.GetSpec()
 		if !spec.HasMutate() {
 			continue
@@ -117,13 +147,14 @@ func (v *mutationHandler) applyMutations(
 				if engineResponse != nil {
 					policyContext = currentContext.WithNewResource(engineResponse.PatchedResource)
 					engineResponses = append(engineResponses, *engineResponse)
+					conflictDetector.add(*engineResponse)
 				}
 
 				return nil
 			},
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -134,11 +165,59 @@ func (v *mutationHandler) applyMutations(
 
 	events := webhookutils.GenerateEvents(engineResponses, false)
 	v.eventGen.Add(events...)
+	v.publisher.Publish(event.AdmissionController, false, engineResponses...)
+
+	conflicts := conflictDetector.result()
+	v.reportConflicts(ctx, request, conflicts)
 
 	logMutationResponse(patches, engineResponses, v.log)
 
 	// patches holds all the successful patches, if no patch is created, it returns nil
-	return jsonutils.JoinPatches(patch.ConvertPatches(patches...)...), engineResponses, nil
+	return jsonutils.JoinPatches(patch.ConvertPatches(patches...)...), engineResponses, conflicts, nil
+}
+
+// reportConflicts logs the conflicts and generates the corresponding events and metrics
+func (v *mutationHandler) reportConflicts(ctx context.Context, request admissionv1.AdmissionRequest, conflicts []mutationConflict) {
+	for _, conflict := range conflicts {
+		v.log.Info("mutation conflict detected", "path", conflict.path, "overriding", conflict.overriding.String(), "overridden", conflict.overridden.String())
+		message := fmt.Sprintf("%s %s: %s", request.Kind.Kind, resourceName(request), conflict)
+		v.eventGen.Add(
+			event.NewMutationConflictEvent(event.AdmissionController, conflict.overriding.policy, message),
+			event.NewMutationConflictEvent(event.AdmissionController, conflict.overridden.policy, message),
+		)
+		if v.conflictsMetric != nil && (v.metrics == nil || v.metrics.Config().CheckNamespace(request.Namespace)) {
+			v.conflictsMetric.Add(
+				ctx,
+				1,
+				metric.WithAttributes(
+					attribute.String("policy_name", conflict.overriding.policy.GetName()),
+					attribute.String("rule_name", conflict.overriding.rule),
+					attribute.String("overridden_policy_name", conflict.overridden.policy.GetName()),
+					attribute.String("overridden_rule_name", conflict.overridden.rule),
+					attribute.String("resource_kind", request.Kind.Kind),
+					attribute.String("resource_namespace", request.Namespace),
+					attribute.String("resource_request_operation", strings.ToLower(string(request.Operation))),
+				),
+			)
+		}
+	}
+}
+
+// sortPolicies orders policies by mutation order, the original order is preserved for policies with the same order
+func sortPolicies(policies []kyvernov1.PolicyInterface) []kyvernov1.PolicyInterface {
+	sorted := make([]kyvernov1.PolicyInterface, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetSpec().GetMutationOrder() < sorted[j].GetSpec().GetMutationOrder()
+	})
+	return sorted
+}
+
+func resourceName(request admissionv1.AdmissionRequest) string {
+	if request.Namespace != "" {
+		return request.Namespace + "/" + request.Name
+	}
+	return request.Name
 }
 
 func (h *mutationHandler) applyMutation(ctx context.Context, request admissionv1.AdmissionRequest, policyContext *engine.PolicyContext) (*engineapi.EngineResponse, []jsonpatch.JsonPatchOperation, error) {
@@ -3,11 +3,13 @@ package validation
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"time"
 
 	"github.com/go-logr/logr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"github.com/kyverno/kyverno/pkg/client/clientset/versioned"
+	"github.com/kyverno/kyverno/pkg/cloudevents"
 	"github.com/kyverno/kyverno/pkg/config"
 	"github.com/kyverno/kyverno/pkg/engine"
 	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
@@ -20,6 +22,7 @@ import (
 	"github.com/kyverno/kyverno/pkg/webhooks/handlers"
 	webhookutils "github.com/kyverno/kyverno/pkg/webhooks/utils"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -39,9 +42,11 @@ func NewValidationHandler(
 	pCache policycache.Cache,
 	pcBuilder webhookutils.PolicyContextBuilder,
 	eventGen event.Interface,
+	publisher cloudevents.Interface,
 	admissionReports bool,
 	metrics metrics.MetricsConfigManager,
 	cfg config.Configuration,
+	workers int,
 ) ValidationHandler {
 	return &validationHandler{
 		log:              log,
@@ -50,9 +55,11 @@ func NewValidationHandler(
 		pCache:           pCache,
 		pcBuilder:        pcBuilder,
 		eventGen:         eventGen,
+		publisher:        publisher,
 		admissionReports: admissionReports,
 		metrics:          metrics,
 		cfg:              cfg,
+		workers:          workers,
 	}
 }
 
@@ -63,9 +70,12 @@ type validationHandler struct {
 	pCache           policycache.Cache
 	pcBuilder        webhookutils.PolicyContextBuilder
 	eventGen         event.Interface
+	publisher        cloudevents.Interface
 	admissionReports bool
 	metrics          metrics.MetricsConfigManager
 	cfg              config.Configuration
+	// workers is the maximum number of policies processed concurrently
+	workers int
 }
 
 func (v *validationHandler) HandleValidation(
@@ -78,54 +88,99 @@ func (v *validationHandler) HandleValidation(
 	resourceName := admissionutils.GetResourceName(request.AdmissionRequest)
 	logger := v.log.WithValues("action", "validate", "resource", resourceName, "operation", request.Operation, "gvk", request.Kind)
 
+	responses := v.validatePolicies(ctx, policies, policyContext)
 	var engineResponses []engineapi.EngineResponse
 	failurePolicy := kyvernov1.Ignore
-	for _, policy := range policies {
-		tracing.ChildSpan(
-			ctx,
-			"pkg/webhooks/resource/validate",
-			fmt.Sprintf("POLICY %s/%s", policy.GetNamespace(), policy.GetName()),
-			func(ctx context.Context, span trace.Span) {
-				policyContext := policyContext.WithPolicy(policy)
-				if policy.GetSpec().GetFailurePolicy() == kyvernov1.Fail {
-					failurePolicy = kyvernov1.Fail
-				}
-
-				engineResponse := v.engine.Validate(ctx, policyContext)
-				if engineResponse.IsNil() {
-					// we get an empty response if old and new resources created the same response
-					// allow updates if resource update doesnt change the policy evaluation
-					return
-				}
-
-				engineResponses = append(engineResponses, engineResponse)
-				if !engineResponse.IsSuccessful() {
-					logger.V(2).Info("validation failed", "action", policy.GetSpec().ValidationFailureAction, "policy", policy.GetName(), "failed rules", engineResponse.GetFailedRules())
-					return
-				}
-
-				if len(engineResponse.GetSuccessRules()) > 0 {
-					logger.V(2).Info("validation passed", "policy", policy.GetName())
-				}
-			},
-		)
+	for i, policy := range policies {
+		if policy.GetSpec().GetFailurePolicy() == kyvernov1.Fail {
+			failurePolicy = kyvernov1.Fail
+		}
+		engineResponse := responses[i]
+		if engineResponse.IsNil() {
+			// we get an empty response if old and new resources created the same response
+			// allow updates if resource update doesnt change the policy evaluation
+			continue
+		}
+		engineResponses = append(engineResponses, engineResponse)
+		if !engineResponse.IsSuccessful() {
+			logger.V(2).Info("validation failed", "action", policy.GetSpec().ValidationFailureAction, "policy", policy.GetName(), "failed rules", engineResponse.GetFailedRules())
+			continue
+		}
+		if len(engineResponse.GetSuccessRules()) > 0 {
+			logger.V(2).Info("validation passed", "policy", policy.GetName())
+		}
 	}
 
 	blocked := webhookutils.BlockRequest(engineResponses, failurePolicy, logger)
 	events := webhookutils.GenerateEvents(engineResponses, blocked)
 	v.eventGen.Add(events...)
+	v.publisher.Publish(event.AdmissionController, blocked, engineResponses...)
 
 	if blocked {
 		logger.V(4).Info("admission request blocked")
-		return false, webhookutils.GetBlockedMessages(engineResponses), nil
+		return false, webhookutils.GetBlockedMessages(engineResponses), webhookutils.GetExplanationWarnings(engineResponses, v.cfg.GetAdmissionExplainValues())
 	}
 
 	go v.handleAudit(ctx, policyContext.NewResource(), request, policyContext.NamespaceLabels(), engineResponses...)
 
 	warnings := webhookutils.GetWarningMessages(engineResponses)
+	warnings = append(warnings, webhookutils.GetExplanationWarnings(engineResponses, v.cfg.GetAdmissionExplainValues())...)
 	return true, "", warnings
 }
 
+// validatePolicies returns the engine responses of the given policies, in the same order as the policies.
+// Policies are processed concurrently when more than one worker is configured, every policy gets its own copy of the JSON context.
+func (v *validationHandler) validatePolicies(
+	ctx context.Context,
+	policies []kyvernov1.PolicyInterface,
+	policyContext *engine.PolicyContext,
+) []engineapi.EngineResponse {
+	responses := make([]engineapi.EngineResponse, len(policies))
+	validate := func(i int, policyContext engineapi.PolicyContext) {
+		policy := policies[i]
+		tracing.ChildSpan(
+			ctx,
+			"pkg/webhooks/resource/validate",
+			fmt.Sprintf("POLICY %s/%s", policy.GetNamespace(), policy.GetName()),
+			func(ctx context.Context, span trace.Span) {
+				responses[i] = v.engine.Validate(ctx, policyContext)
+			},
+		)
+	}
+	if v.workers <= 1 || len(policies) <= 1 {
+		for i, policy := range policies {
+			validate(i, policyContext.WithPolicy(policy))
+		}
+		return responses
+	}
+	var group errgroup.Group
+	group.SetLimit(v.workers)
+	for i, policy := range policies {
+		i := i
+		policyContext := policyContext.WithPolicy(policy).Clone()
+		group.Go(func() error {
+			startTime := time.Now()
+			// a panic in a worker would crash the process, it is reported as a rule error instead
+			defer func() {
+				if r := recover(); r != nil {
+					err := fmt.Errorf("panic: %v", r)
+					v.log.Error(err, "policy processing panicked", "policy", policies[i].GetName(), "stack", string(debug.Stack()))
+					policyResponse := engineapi.NewPolicyResponse()
+					policyResponse.Add(
+						engineapi.NewExecutionStats(startTime, time.Now()),
+						*engineapi.RuleError(policies[i].GetName(), engineapi.Validation, "failed to process policy", err),
+					)
+					responses[i] = engineapi.NewEngineResponseFromPolicyContext(policyContext).WithPolicyResponse(policyResponse)
+				}
+			}()
+			validate(i, policyContext)
+			return nil
+		})
+	}
+	_ = group.Wait()
+	return responses
+}
+
 func (v *validationHandler) buildAuditResponses(
 	ctx context.Context,
 	resource unstructured.Unstructured,
@@ -133,7 +188,14 @@ func (v *validationHandler) buildAuditResponses(
 	namespaceLabels map[string]string,
 ) ([]engineapi.EngineResponse, error) {
 	gvr := schema.GroupVersionResource(request.Resource)
-	policies := v.pCache.GetPolicies(policycache.ValidateAudit, gvr, request.SubResource, request.Namespace)
+	candidates := v.pCache.GetCandidates(policycache.ValidateAudit, gvr, request.SubResource, request.Namespace, policycache.MatchRequest{
+		Operation:       kyvernov1.AdmissionOperation(request.Operation),
+		NamespaceLabels: namespaceLabels,
+	})
+	var policies []kyvernov1.PolicyInterface
+	for _, candidate := range candidates {
+		policies = append(policies, candidate.Policy)
+	}
 	policyContext, err := v.pcBuilder.Build(request.AdmissionRequest, request.Roles, request.ClusterRoles, request.GroupVersionKind)
 	if err != nil {
 		return nil, err
@@ -188,6 +250,7 @@ func (v *validationHandler) handleAudit(
 			}
 			events := webhookutils.GenerateEvents(responses, false)
 			v.eventGen.Add(events...)
+			v.publisher.Publish(event.AdmissionController, false, responses...)
 			if createReport {
 				responses = append(responses, engineResponses...)
 				report := reportutils.BuildAdmissionReport(resource, request.AdmissionRequest, responses...)
@@ -1057,8 +1057,9 @@ func TestValidate_failure_action_overrides(t *testing.T) {
 		jp,
 		nil,
 		registryclient.NewOrDie(),
-		engineapi.DefaultContextLoaderFactory(nil),
+		engineapi.DefaultContextLoaderFactory(nil, nil),
 		nil,
+		1,
 	)
 	for i, tc := range testcases {
 		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
@@ -1158,8 +1159,9 @@ func Test_RuleSelector(t *testing.T) {
 		jp,
 		nil,
 		registryclient.NewOrDie(),
-		engineapi.DefaultContextLoaderFactory(nil),
+		engineapi.DefaultContextLoaderFactory(nil, nil),
 		nil,
+		1,
 	)
 	resp := eng.Validate(
 		context.TODO(),
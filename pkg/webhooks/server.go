@@ -65,7 +65,8 @@ type server struct {
 	leaseClient controllerutils.DeleteClient[*coordinationv1.Lease]
 }
 
-type TlsProvider func() ([]byte, []byte, error)
+// TlsProvider returns the key pair served by the server, it is called on every TLS handshake
+type TlsProvider func() (*tls.Certificate, error)
 
 // NewServer creates new instance of server accordingly to given configuration
 func NewServer(
@@ -76,6 +77,7 @@ func NewServer(
 	metricsConfig metrics.MetricsConfigManager,
 	debugModeOpts DebugModeOptions,
 	tlsProvider TlsProvider,
+	serverOptions ServerOptions,
 	mwcClient controllerutils.DeleteCollectionClient[*admissionregistrationv1.MutatingWebhookConfiguration],
 	vwcClient controllerutils.DeleteCollectionClient[*admissionregistrationv1.ValidatingWebhookConfiguration],
 	leaseClient controllerutils.DeleteClient[*coordinationv1.Lease],
@@ -97,7 +99,7 @@ func NewServer(
 		func(handler handlers.AdmissionHandler) handlers.HttpHandler {
 			return handler.
 				WithFilter(configuration).
-				WithProtection(toggle.ProtectManagedResources.Enabled()).
+				WithProtection(toggle.ProtectManagedResources.Enabled(), configuration).
 				WithDump(debugModeOpts.DumpPayload).
 				WithTopLevelGVK(discovery).
 				WithRoles(rbLister, crbLister).
@@ -114,7 +116,7 @@ func NewServer(
 		func(handler handlers.AdmissionHandler) handlers.HttpHandler {
 			return handler.
 				WithFilter(configuration).
-				WithProtection(toggle.ProtectManagedResources.Enabled()).
+				WithProtection(toggle.ProtectManagedResources.Enabled(), configuration).
 				WithDump(debugModeOpts.DumpPayload).
 				WithTopLevelGVK(discovery).
 				WithRoles(rbLister, crbLister).
@@ -161,38 +163,7 @@ func NewServer(
 	mux.HandlerFunc("GET", config.LivenessServicePath, handlers.Probe(runtime.IsLive))
 	mux.HandlerFunc("GET", config.ReadinessServicePath, handlers.Probe(runtime.IsReady))
 	return &server{
-		server: &http.Server{
-			Addr: ":9443",
-			TLSConfig: &tls.Config{
-				GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
-					certPem, keyPem, err := tlsProvider()
-					if err != nil {
-						return nil, err
-					}
-					pair, err := tls.X509KeyPair(certPem, keyPem)
-					if err != nil {
-						return nil, err
-					}
-					return &pair, nil
-				},
-				MinVersion: tls.VersionTLS12,
-				CipherSuites: []uint16{
-					// AEADs w/ ECDHE
-					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-					tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-				},
-			},
-			Handler:           mux,
-			ReadTimeout:       30 * time.Second,
-			WriteTimeout:      30 * time.Second,
-			ReadHeaderTimeout: 30 * time.Second,
-			IdleTimeout:       5 * time.Minute,
-			ErrorLog:          logging.StdLogger(logger.WithName("server"), ""),
-		},
+		server:      NewHttpServer(serverOptions, mux, tlsProvider, logging.StdLogger(logger.WithName("server"), "")),
 		mwcClient:   mwcClient,
 		vwcClient:   vwcClient,
 		leaseClient: leaseClient,
@@ -13,6 +13,7 @@ func GenerateEvents(engineResponses []engineapi.EngineResponse, blocked bool) []
 	//     - report failure events on resource
 	//   - Some/All policies succeeded
 	//     - report success event on resource
+	//     - report mutation events on resource
 	//   - Some/All policies skipped
 	//     - report skipped event on resource
 	for _, er := range engineResponses {
@@ -39,6 +40,11 @@ func GenerateEvents(engineResponses []engineapi.EngineResponse, blocked bool) []
 		} else if !er.IsSkipped() {
 			e := event.NewPolicyAppliedEvent(event.AdmissionController, er)
 			events = append(events, e)
+			for _, ruleResp := range er.PolicyResponse.Rules {
+				if ruleResp.Status() == engineapi.RuleStatusPass && len(ruleResp.Patches()) != 0 {
+					events = append(events, event.NewResourceMutatedEvent(event.AdmissionController, er, ruleResp))
+				}
+			}
 		}
 	}
 	return events
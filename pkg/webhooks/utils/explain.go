@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	admissionutils "github.com/kyverno/kyverno/pkg/utils/admission"
+	admissionv1 "k8s.io/api/admission/v1"
+)
+
+// ExplainAnnotation is the annotation a resource can carry to request an explanation of the rules evaluated at admission
+const ExplainAnnotation = "kyverno.io/explain"
+
+// IsExplainRequested returns true if admission explanations are enabled in the configuration
+// and the resource being admitted (or deleted) carries the explain annotation
+func IsExplainRequested(configuration config.Configuration, request admissionv1.AdmissionRequest) bool {
+	if !configuration.GetAdmissionExplain() {
+		return false
+	}
+	newResource, oldResource, err := admissionutils.ExtractResources(nil, request)
+	if err != nil {
+		return false
+	}
+	resource := newResource
+	if resource.Object == nil {
+		resource = oldResource
+	}
+	enabled, _ := strconv.ParseBool(resource.GetAnnotations()[ExplainAnnotation])
+	return enabled
+}
+
+// GetExplanationWarnings returns the explanation steps recorded for rules that didn't pass, one warning per step.
+// Resolved values and errors can contain data the requester is not allowed to read (config maps, api calls),
+// they are redacted unless showValues is true.
+func GetExplanationWarnings(engineResponses []engineapi.EngineResponse, showValues bool) []string {
+	var warnings []string
+	for _, er := range engineResponses {
+		for _, rule := range er.PolicyResponse.Rules {
+			if rule.Status() == engineapi.RuleStatusPass {
+				continue
+			}
+			for _, step := range rule.Explanation().Steps() {
+				if !showValues {
+					step = redactStep(step)
+				}
+				warnings = append(warnings, fmt.Sprintf("policy %s.%s explain: %s", er.Policy().GetName(), rule.Name(), step))
+			}
+		}
+	}
+	return warnings
+}
+
+func redactStep(step engineapi.ExplanationStep) engineapi.ExplanationStep {
+	if step.Value != nil {
+		step.Value = "<redacted>"
+	}
+	if step.Error != "" {
+		step.Error = "<redacted>"
+	}
+	return step
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"testing"
+
+	v1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/config"
+	engineapi "github.com/kyverno/kyverno/pkg/engine/api"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIsExplainRequested(t *testing.T) {
+	tests := []struct {
+		name    string
+		request admissionv1.AdmissionRequest
+		want    bool
+	}{{
+		name:    "no object",
+		request: admissionv1.AdmissionRequest{},
+		want:    false,
+	}, {
+		name: "no annotation",
+		request: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test"}}`)},
+		},
+		want: false,
+	}, {
+		name: "annotation",
+		request: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test","annotations":{"kyverno.io/explain":"true"}}}`)},
+		},
+		want: true,
+	}, {
+		name: "annotation disabled",
+		request: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test","annotations":{"kyverno.io/explain":"false"}}}`)},
+		},
+		want: false,
+	}, {
+		name: "annotation on deleted object",
+		request: admissionv1.AdmissionRequest{
+			OldObject: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test","annotations":{"kyverno.io/explain":"true"}}}`)},
+		},
+		want: true,
+	}}
+	disabled := config.NewDefaultConfiguration(false)
+	enabled := config.NewDefaultConfiguration(false)
+	enabled.Load(&corev1.ConfigMap{Data: map[string]string{"admissionExplain": "true"}})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsExplainRequested(enabled, tt.request))
+			assert.Equal(t, false, IsExplainRequested(disabled, tt.request))
+		})
+	}
+}
+
+func TestGetExplanationWarnings(t *testing.T) {
+	explanation := &engineapi.Explanation{}
+	explanation.Add(engineapi.ExplanationStep{
+		Type:   engineapi.ExplanationPattern,
+		Name:   "pattern /metadata/labels/team/",
+		Result: "fail",
+	})
+	explanation.Add(engineapi.ExplanationStep{
+		Type:  engineapi.ExplanationVariable,
+		Name:  "secret.data.token",
+		Value: "s3cr3t",
+	})
+	responses := []engineapi.EngineResponse{
+		engineapi.EngineResponse{
+			PolicyResponse: engineapi.PolicyResponse{
+				Rules: []engineapi.RuleResponse{
+					*engineapi.RulePass("rule-pass", engineapi.Validation, "message pass").WithExplanation(explanation),
+					*engineapi.RuleFail("rule-fail", engineapi.Validation, "message fail").WithExplanation(explanation),
+					*engineapi.RuleFail("rule-no-explanation", engineapi.Validation, "message fail"),
+				},
+			},
+		}.WithPolicy(&v1.ClusterPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+		}),
+	}
+	assert.Equal(t, []string{
+		"policy test.rule-fail explain: Pattern pattern /metadata/labels/team/ -> fail",
+		"policy test.rule-fail explain: Variable secret.data.token = s3cr3t",
+	}, GetExplanationWarnings(responses, true))
+	assert.Equal(t, []string{
+		"policy test.rule-fail explain: Pattern pattern /metadata/labels/team/ -> fail",
+		"policy test.rule-fail explain: Variable secret.data.token = <redacted>",
+	}, GetExplanationWarnings(responses, false))
+}